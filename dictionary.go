@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"hangman/hangman"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// dictionaryFlags collects the game flags that import a plain word list.
+type dictionaryFlags struct {
+	path        string
+	category    string
+	minLength   int
+	maxLength   int
+	pattern     string
+	properNouns bool
+	frequency   string
+	maxRank     int
+}
+
+// register adds the dictionary flags to flags.
+func (d *dictionaryFlags) register(flags *flag.FlagSet) {
+	flags.StringVar(&d.path, "dict", "", "plain word list (one word per line, optional part of speech) to play as a category")
+	flags.StringVar(&d.category, "dict-category", "", "category title for -dict (default: file name)")
+	flags.IntVar(&d.minLength, "dict-min", 4, "shortest dictionary word to keep")
+	flags.IntVar(&d.maxLength, "dict-max", 12, "longest dictionary word to keep, 0 for no limit")
+	flags.StringVar(&d.pattern, "dict-pattern", "", "keep only dictionary words matching this regular expression")
+	flags.BoolVar(&d.properNouns, "dict-proper-nouns", false, "keep capitalised dictionary entries")
+	flags.StringVar(&d.frequency, "dict-freq", "", "word frequency list, most common first, used with -dict-max-rank")
+	flags.IntVar(&d.maxRank, "dict-max-rank", 0, "keep only the N most frequent dictionary words")
+}
+
+// dictionary builds the dictionary import from the parsed flags, or nil when -dict is unset.
+func (d *dictionaryFlags) dictionary() (*hangman.Dictionary, error) {
+	if d.path == "" {
+		return nil, nil
+	}
+
+	opts := hangman.DictionaryOptions{
+		Category:           d.category,
+		MinLength:          d.minLength,
+		MaxLength:          d.maxLength,
+		ExcludeProperNouns: !d.properNouns,
+		MaxRank:            d.maxRank,
+	}
+	if opts.Category == "" {
+		opts.Category = strings.TrimSuffix(filepath.Base(d.path), filepath.Ext(d.path))
+	}
+
+	if d.pattern != "" {
+		pattern, err := regexp.Compile(d.pattern)
+		if err != nil {
+			return nil, err
+		}
+		opts.Pattern = pattern
+	}
+
+	if d.frequency != "" {
+		file, err := os.Open(d.frequency)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		if opts.Frequency, err = hangman.ReadFrequencyList(file); err != nil {
+			return nil, err
+		}
+	}
+
+	return &hangman.Dictionary{Path: d.path, Options: opts}, nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"hangman/hangman"
+	"os"
+)
+
+// runEdit opens the interactive word editor and returns the process exit code.
+func runEdit(args []string) int {
+	flags := flag.NewFlagSet("edit", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: hangman edit [-data dir | -pack name|dir]")
+		flags.PrintDefaults()
+	}
+	dir := flags.String("data", hangman.DefaultDataDir, "directory containing word files")
+	pack := flags.String("pack", "", "installed pack name or unpacked pack directory to edit instead of -data")
+	packsDir := flags.String("packs", defaultPacksDir(), "directory containing installed word packs")
+	flags.Parse(args)
+
+	if flags.NArg() != 0 {
+		flags.Usage()
+		return 2
+	}
+
+	loader := hangman.NewWordLoader()
+	loader.SetMode(hangman.LoadModeLenient)
+
+	var err error
+	if *pack != "" {
+		packDir := *pack
+		if installed, err := hangman.NewPackStore(*packsDir).Info(*pack); err == nil {
+			packDir = installed.Dir
+		}
+		err = loader.LoadPack(packDir)
+	} else {
+		err = loader.Load(*dir)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	for _, diag := range loader.Diagnostics() {
+		fmt.Fprintf(os.Stderr, "skipped %s\n", diag.Error())
+	}
+
+	if err := hangman.NewEditor(loader).Run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
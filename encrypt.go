@@ -0,0 +1,123 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"hangman/hangman"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// passphraseEnv names the environment variable holding the word file passphrase.
+const passphraseEnv = "HANGMAN_PASSPHRASE"
+
+// readSecret returns the key from keyFile when set, otherwise the passphrase
+// from the environment; both may be empty.
+func readSecret(keyFile string) (hangman.Secret, error) {
+	if keyFile != "" {
+		key, err := hangman.ReadKeyFile(keyFile)
+		return hangman.Secret{Key: key}, err
+	}
+	return hangman.Secret{Passphrase: os.Getenv(passphraseEnv)}, nil
+}
+
+// runEncrypt encrypts word files for curators and returns the process exit code.
+func runEncrypt(args []string) int {
+	flags := flag.NewFlagSet("encrypt", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: hangman encrypt [flags] <file ...>")
+		fmt.Fprintf(flags.Output(), "\nWithout -key-file the passphrase is read from $%s.\n\n", passphraseEnv)
+		flags.PrintDefaults()
+	}
+	keyFile := flags.String("key-file", "", "base64 key file created with -keygen")
+	keygen := flags.String("keygen", "", "write a new random key file to this path and exit")
+	out := flags.String("out", "", "directory for encrypted files, outside the directory of the inputs")
+	replace := flags.Bool("replace", false, "overwrite each input with its encrypted form")
+	flags.Parse(args)
+
+	if *keygen != "" {
+		if err := hangman.GenerateKeyFile(*keygen); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Printf("wrote key file %s\n", *keygen)
+		return 0
+	}
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+	if !*replace && *out == "" {
+		fmt.Fprintln(os.Stderr, "set -replace or -out: the plaintext would stay next to the encrypted file and both would be loaded")
+		return 2
+	}
+
+	secret, err := readSecret(*keyFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if secret.IsZero() {
+		fmt.Fprintf(os.Stderr, "set -key-file or $%s\n", passphraseEnv)
+		return 2
+	}
+
+	for _, path := range flags.Args() {
+		target := path
+		if !*replace {
+			if within(*out, filepath.Dir(path)) {
+				fmt.Fprintf(os.Stderr, "-out %s is inside the directory of %s, where the plaintext would still be loaded\n", *out, path)
+				return 2
+			}
+			target = filepath.Join(*out, filepath.Base(path)+".enc")
+		}
+
+		if err := encryptFile(path, target, secret); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Printf("%s -> %s\n", path, target)
+	}
+	return 0
+}
+
+// within reports whether dir is parent or one of its subdirectories.
+func within(dir, parent string) bool {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return false
+	}
+	parent, err = filepath.Abs(parent)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(parent, dir)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// encryptFile checks that path is a valid word file and writes its encrypted form to target.
+func encryptFile(path, target string, secret hangman.Secret) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if hangman.IsEncrypted(data) {
+		return fmt.Errorf("%s is already encrypted", path)
+	}
+	if _, _, err := hangman.NewWordLoader().LoadFile(path); err != nil {
+		return err
+	}
+
+	encrypted, err := hangman.EncryptWords(data, secret)
+	if err != nil {
+		return err
+	}
+
+	tmp := target + ".tmp"
+	if err := os.WriteFile(tmp, encrypted, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, target)
+}
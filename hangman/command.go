@@ -0,0 +1,107 @@
+package hangman
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// CommandPrefix starts an in-game command at the guess prompt, e.g. ":hint".
+const CommandPrefix = ":"
+
+// Command is an in-game command entered at the guess prompt.
+type Command string
+
+const (
+	CommandHint    = Command("hint")
+	CommandSolve   = Command("solve")
+	CommandSave    = Command("save")
+	CommandMenu    = Command("menu")
+	CommandForfeit = Command("forfeit")
+	CommandStats   = Command("stats")
+	CommandHelp    = Command("help")
+	CommandQuit    = Command("quit")
+)
+
+// commandHelp describes every command, in the order shown by :help.
+var commandHelp = []struct {
+	command Command
+	usage   string
+	help    string
+}{
+	{CommandHint, ":hint [kind]", "show the next hint, or a structural hint such as :hint vowels"},
+	{CommandSolve, ":solve <answer>", "guess the whole answer"},
+	{CommandSave, ":save", "save the round to resume it later"},
+	{CommandMenu, ":menu", "forfeit the round and go back to the menu (Ctrl-C)"},
+	{CommandForfeit, ":forfeit", "same as :menu"},
+	{CommandStats, ":stats", "show the statistics of this session"},
+	{CommandHelp, ":help", "list the commands"},
+	{CommandQuit, ":quit", "quit the game (Ctrl-D)"},
+}
+
+// parseCommand splits a ":name [argument]" entry. It reports false when the
+// entry is not a command, and an error when the command is unknown or
+// misses its argument.
+func parseCommand(input string) (Command, string, bool, error) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(input), CommandPrefix)
+	if !ok {
+		return "", "", false, nil
+	}
+
+	name, arg, _ := strings.Cut(rest, " ")
+	command, arg := Command(strings.ToLower(name)), strings.TrimSpace(arg)
+
+	for _, entry := range commandHelp {
+		if entry.command != command {
+			continue
+		}
+		if command == CommandSolve && arg == "" {
+			return command, arg, true, fmt.Errorf("usage: %s", entry.usage)
+		}
+		return command, arg, true, nil
+	}
+	return command, arg, true, fmt.Errorf("unknown command %s%s, enter :help for a list", CommandPrefix, name)
+}
+
+// runCommand executes a command during a round. Commands handled by the
+// round itself return false; the others end Play with the returned state,
+// which Hangman.Start acts on.
+func (g *HangmanGame) runCommand(command Command, arg string) (GameState, bool) {
+	switch command {
+	case CommandHint:
+		hint, err := g.requestHint(HintKind(arg))
+		if err != nil {
+			logrus.Warn(err)
+		} else {
+			logrus.Infof("Hint %d: %s", len(g.hintsUsed), hint)
+		}
+	case CommandSolve:
+		if !g.solve(arg) {
+			logrus.Warn("that is not the answer")
+		}
+	case CommandHelp:
+		logrus.Info(commandUsage())
+	case CommandSave:
+		return GameStateSave, true
+	case CommandMenu, CommandForfeit:
+		return GameStateAbandon, true
+	case CommandStats:
+		return GameStateStats, true
+	case CommandQuit:
+		return GameStateQuit, true
+	}
+	return "", false
+}
+
+// commandUsage lists the commands and the other entries the prompt accepts.
+func commandUsage() string {
+	builder := new(strings.Builder)
+	builder.WriteString("Commands:\n")
+	for _, entry := range commandHelp {
+		fmt.Fprintf(builder, "  %-18s %s\n", entry.usage, entry.help)
+	}
+	fmt.Fprintf(builder, "  %-18s %s\n", HintCommand+" [kind]", "same as :hint")
+	fmt.Fprintf(builder, "  %-18s %s", PowerUpCommand+" <power-up>", "use a power-up: "+powerUpList())
+	return builder.String()
+}
@@ -0,0 +1,71 @@
+package hangman
+
+import "testing"
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		input       string
+		wantCommand Command
+		wantArg     string
+		wantOK      bool
+		wantErr     bool
+	}{
+		{"a", "", "", false, false},
+		{"? vowels", "", "", false, false},
+		{":hint", CommandHint, "", true, false},
+		{":hint vowels", CommandHint, "vowels", true, false},
+		{" :SOLVE  Ice Cream ", CommandSolve, "Ice Cream", true, false},
+		{":solve", CommandSolve, "", true, true},
+		{":save", CommandSave, "", true, false},
+		{":menu", CommandMenu, "", true, false},
+		{":forfeit", CommandForfeit, "", true, false},
+		{":stats", CommandStats, "", true, false},
+		{":help", CommandHelp, "", true, false},
+		{":quit", CommandQuit, "", true, false},
+		{":dance", "dance", "", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			command, arg, ok, err := parseCommand(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCommand() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if command != tt.wantCommand || arg != tt.wantArg || ok != tt.wantOK {
+				t.Errorf("parseCommand() = %q, %q, %v, want %q, %q, %v",
+					command, arg, ok, tt.wantCommand, tt.wantArg, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestHangmanGame_runCommand(t *testing.T) {
+	tests := []struct {
+		command   Command
+		arg       string
+		wantState GameState
+		wantDone  bool
+	}{
+		{CommandHint, "", "", false},
+		{CommandSolve, "Ice Cream", "", false},
+		{CommandHelp, "", "", false},
+		{CommandSave, "", GameStateSave, true},
+		{CommandMenu, "", GameStateAbandon, true},
+		{CommandForfeit, "", GameStateAbandon, true},
+		{CommandStats, "", GameStateStats, true},
+		{CommandQuit, "", GameStateQuit, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.command), func(t *testing.T) {
+			game, err := NewHangmanGame(&Word{Text: "Ice Cream", Hint: "Frozen dessert"}, 3)
+			if err != nil {
+				t.Fatalf("NewHangmanGame() unexpected error = %v", err)
+			}
+			state, done := game.runCommand(tt.command, tt.arg)
+			if state != tt.wantState || done != tt.wantDone {
+				t.Errorf("runCommand() = %q, %v, want %q, %v", state, done, tt.wantState, tt.wantDone)
+			}
+		})
+	}
+}
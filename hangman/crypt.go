@@ -0,0 +1,183 @@
+package hangman
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Encrypted word files start with encryptedMagic followed by a header:
+//
+//	kdf (1 byte) | iterations (4 bytes, big endian) | salt (16 bytes) | nonce (12 bytes)
+//
+// and the AES-256-GCM ciphertext of the plain word file. The whole header is
+// authenticated as additional data.
+const (
+	encryptedMagic   = "HANGMAN-ENC1"
+	kdfKeyFile       = byte(0)
+	kdfPassphrase    = byte(1)
+	pbkdf2Iterations = 600_000
+	// maxPbkdf2Iterations bounds the count read from a file header so a
+	// crafted file cannot stall the loader.
+	maxPbkdf2Iterations = 4 * pbkdf2Iterations
+	saltSize            = 16
+	keySize             = 32
+)
+
+// Secret holds the material used to encrypt and decrypt word files.
+// Key takes precedence over Passphrase when both are set.
+type Secret struct {
+	Passphrase string
+	Key        []byte
+}
+
+// IsZero reports whether no key or passphrase was provided.
+func (s Secret) IsZero() bool {
+	return s.Passphrase == "" && len(s.Key) == 0
+}
+
+// IsEncrypted reports whether data is an encrypted word file.
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(encryptedMagic))
+}
+
+// EncryptWords encrypts the content of a word file with the secret.
+func EncryptWords(plain []byte, secret Secret) ([]byte, error) {
+	if secret.IsZero() {
+		return nil, errors.New("a key or passphrase is required to encrypt")
+	}
+
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	kdf, iterations := kdfKeyFile, uint32(0)
+	if len(secret.Key) == 0 {
+		kdf, iterations = kdfPassphrase, pbkdf2Iterations
+	}
+
+	header := []byte(encryptedMagic)
+	header = append(header, kdf)
+	header = binary.BigEndian.AppendUint32(header, iterations)
+	header = append(header, salt...)
+
+	aead, err := newAEAD(secret, kdf, iterations, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	header = append(header, nonce...)
+
+	return aead.Seal(header, nonce, plain, header), nil
+}
+
+// DecryptWords decrypts an encrypted word file with the secret.
+func DecryptWords(data []byte, secret Secret) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return nil, errors.New("not an encrypted word file")
+	}
+	if secret.IsZero() {
+		return nil, errors.New("file is encrypted but no key or passphrase was provided")
+	}
+
+	rest := data[len(encryptedMagic):]
+	if len(rest) < 1+4+saltSize {
+		return nil, errors.New("encrypted word file is truncated")
+	}
+
+	kdf := rest[0]
+	iterations := binary.BigEndian.Uint32(rest[1:5])
+	salt := rest[5 : 5+saltSize]
+
+	aead, err := newAEAD(secret, kdf, iterations, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	headerSize := len(encryptedMagic) + 1 + 4 + saltSize + aead.NonceSize()
+	if len(data) < headerSize {
+		return nil, errors.New("encrypted word file is truncated")
+	}
+
+	header := data[:headerSize]
+	nonce := header[headerSize-aead.NonceSize():]
+	plain, err := aead.Open(nil, nonce, data[headerSize:], header)
+	if err != nil {
+		return nil, errors.New("cannot decrypt word file: wrong key or passphrase, or the file was modified")
+	}
+	return plain, nil
+}
+
+// newAEAD derives the file key and returns the AES-256-GCM cipher.
+func newAEAD(secret Secret, kdf byte, iterations uint32, salt []byte) (cipher.AEAD, error) {
+	var key []byte
+	switch kdf {
+	case kdfKeyFile:
+		if len(secret.Key) != keySize {
+			return nil, fmt.Errorf("file is encrypted with a %d-byte key file", keySize)
+		}
+		key = secret.Key
+	case kdfPassphrase:
+		if secret.Passphrase == "" {
+			return nil, errors.New("file is encrypted with a passphrase")
+		}
+		if iterations == 0 || iterations > maxPbkdf2Iterations {
+			return nil, fmt.Errorf("unsupported PBKDF2 iteration count %d", iterations)
+		}
+		var err error
+		key, err = pbkdf2.Key(sha256.New, secret.Passphrase, salt, int(iterations), keySize)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown key derivation %d", kdf)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// GenerateKeyFile writes a new random base64-encoded key to path with mode 0600.
+func GenerateKeyFile(path string) error {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return err
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(key) + "\n"
+	return os.WriteFile(path, []byte(encoded), 0o600)
+}
+
+// ReadKeyFile reads a base64-encoded key written by GenerateKeyFile.
+func ReadKeyFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(key) != keySize {
+		return nil, fmt.Errorf("%s: expected a %d-byte key, got %d bytes", path, keySize, len(key))
+	}
+	return key, nil
+}
@@ -0,0 +1,138 @@
+package hangman
+
+import (
+	"bytes"
+	"encoding/binary"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestEncryptWords(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "words.key")
+	if err := GenerateKeyFile(keyFile); err != nil {
+		t.Fatalf("GenerateKeyFile() unexpected error = %v", err)
+	}
+	key, err := ReadKeyFile(keyFile)
+	if err != nil {
+		t.Fatalf("ReadKeyFile() unexpected error = %v", err)
+	}
+
+	plain := []byte("Animals\nCat,Kitty\n")
+
+	tests := []struct {
+		name    string
+		secret  Secret
+		decrypt Secret
+		tamper  bool
+		// iterations, when set, overwrites the PBKDF2 count in the header.
+		iterations uint32
+		wantErr    bool
+	}{
+		{
+			name:    "key file",
+			secret:  Secret{Key: key},
+			decrypt: Secret{Key: key},
+		},
+		{
+			name:    "passphrase",
+			secret:  Secret{Passphrase: "correct horse"},
+			decrypt: Secret{Passphrase: "correct horse"},
+		},
+		{
+			name:    "wrong passphrase",
+			secret:  Secret{Passphrase: "correct horse"},
+			decrypt: Secret{Passphrase: "battery staple"},
+			wantErr: true,
+		},
+		{
+			name:    "passphrase file opened with key",
+			secret:  Secret{Passphrase: "correct horse"},
+			decrypt: Secret{Key: key},
+			wantErr: true,
+		},
+		{
+			name:    "no secret",
+			secret:  Secret{Key: key},
+			wantErr: true,
+		},
+		{
+			name:    "tampered ciphertext",
+			secret:  Secret{Key: key},
+			decrypt: Secret{Key: key},
+			tamper:  true,
+			wantErr: true,
+		},
+		{
+			name:       "excessive iteration count",
+			secret:     Secret{Passphrase: "correct horse"},
+			decrypt:    Secret{Passphrase: "correct horse"},
+			iterations: 1 << 31,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encrypted, err := EncryptWords(plain, tt.secret)
+			if err != nil {
+				t.Fatalf("EncryptWords() unexpected error = %v", err)
+			}
+			if !IsEncrypted(encrypted) || bytes.Contains(encrypted, []byte("Kitty")) {
+				t.Fatal("EncryptWords() output is not encrypted")
+			}
+			if tt.tamper {
+				encrypted[len(encrypted)-1] ^= 0xff
+			}
+			if tt.iterations != 0 {
+				binary.BigEndian.PutUint32(encrypted[len(encryptedMagic)+1:], tt.iterations)
+			}
+
+			decrypted, err := DecryptWords(encrypted, tt.decrypt)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DecryptWords() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !bytes.Equal(decrypted, plain) {
+				t.Errorf("DecryptWords() = %q, want %q", decrypted, plain)
+			}
+		})
+	}
+}
+
+func TestWordLoader_LoadEncrypted(t *testing.T) {
+	secret := Secret{Key: bytes.Repeat([]byte{7}, keySize)}
+	encrypted, err := EncryptWords([]byte("Animals\nCat,Kitty\nDog,Puppy\n"), secret)
+	if err != nil {
+		t.Fatalf("EncryptWords() unexpected error = %v", err)
+	}
+	fsys := fstest.MapFS{
+		"animals.txt": {Data: encrypted},
+		"fruits.txt":  {Data: []byte("Fruits\nApple,Keeps the doctor away\n")},
+	}
+
+	loader := NewWordLoader()
+	loader.SetMode(LoadModeLenient)
+	if err := loader.LoadFS(fsys, "."); err != nil {
+		t.Fatalf("WordLoader.LoadFS() unexpected error = %v", err)
+	}
+	if !reflect.DeepEqual(loader.Categories(), []string{"Fruits"}) {
+		t.Errorf("WordLoader.Categories() without secret = %v, want [Fruits]", loader.Categories())
+	}
+	if len(loader.Diagnostics()) != 1 || loader.Diagnostics()[0].Path != "animals.txt" {
+		t.Errorf("WordLoader.Diagnostics() = %v, want one entry for animals.txt", loader.Diagnostics())
+	}
+
+	loader = NewWordLoader()
+	loader.SetSecret(secret)
+	if err := loader.LoadFS(fsys, "."); err != nil {
+		t.Fatalf("WordLoader.LoadFS() unexpected error = %v", err)
+	}
+	words, err := loader.GetWords("Animals")
+	if err != nil {
+		t.Fatalf("WordLoader.GetWords() unexpected error = %v", err)
+	}
+	if len(words) != 2 || words[0].Text != "Cat" {
+		t.Errorf("WordLoader.GetWords() = %v, want Cat and Dog", words)
+	}
+}
@@ -0,0 +1,34 @@
+package hangman
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Diagnostic describes a problem found while loading a word file.
+// Line is 1-based; a zero Line means the problem applies to the whole file.
+type Diagnostic struct {
+	Path   string `json:"path"`
+	Line   int    `json:"line,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// Error formats the diagnostic as "path:line: reason".
+func (d Diagnostic) Error() string {
+	if d.Line == 0 {
+		return fmt.Sprintf("%s: %s", d.Path, d.Reason)
+	}
+	return fmt.Sprintf("%s:%d: %s", d.Path, d.Line, d.Reason)
+}
+
+// Diagnostics is a list of problems collected while loading word files.
+type Diagnostics []Diagnostic
+
+// Error joins all diagnostics, one per line.
+func (d Diagnostics) Error() string {
+	lines := make([]string, 0, len(d))
+	for _, diag := range d {
+		lines = append(lines, diag.Error())
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,175 @@
+package hangman
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DictionaryOptions filters the entries of a plain word list imported by
+// ImportDictionary. Zero values disable the corresponding filter.
+type DictionaryOptions struct {
+	// Category is the title of the generated category.
+	Category string
+	// MinLength and MaxLength bound the number of letters in a word.
+	MinLength int
+	MaxLength int
+	// Pattern keeps only words matching the regular expression.
+	Pattern *regexp.Regexp
+	// ExcludeProperNouns drops capitalised entries such as "Paris".
+	ExcludeProperNouns bool
+	// Frequency ranks words from most (1) to least common; see ReadFrequencyList.
+	Frequency map[string]int
+	// MaxRank keeps only words ranked at or above this position in Frequency.
+	MaxRank int
+}
+
+// Dictionary is a plain word list to import as a category.
+type Dictionary struct {
+	Path    string
+	Options DictionaryOptions
+}
+
+// ImportDictionary builds a category from a newline-separated word list
+// such as /usr/share/dict/words. Each line holds a word, optionally followed
+// by whitespace and its part of speech. Hints are generated from the word's
+// length, first letter and part of speech. path is recorded as the words' source.
+func ImportDictionary(r io.Reader, path string, opts DictionaryOptions) ([]Word, error) {
+	if opts.Category == "" {
+		return nil, errors.New("dictionary category cannot be empty")
+	}
+
+	words := []Word{}
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+
+	for scanner.Scan() {
+		lineNo++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		text := fields[0]
+		key := strings.ToLower(text)
+		if seen[key] || !opts.keep(text) {
+			continue
+		}
+		seen[key] = true
+
+		partOfSpeech := ""
+		if len(fields) > 1 {
+			partOfSpeech = strings.Join(fields[1:], " ")
+		}
+
+		word := Word{
+			Text:     text,
+			Category: opts.Category,
+			Source:   Source{Path: path, Line: lineNo},
+		}
+		hints := dictionaryHints(&word, partOfSpeech)
+		word.Hint, word.ExtraHints = hints[0], hints[1:]
+		words = append(words, word)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(words) == 0 {
+		return nil, fmt.Errorf("no dictionary words in %s match the filters", path)
+	}
+
+	return words, nil
+}
+
+// keep reports whether a dictionary entry passes every filter.
+func (o DictionaryOptions) keep(text string) bool {
+	for _, r := range text {
+		if r > unicode.MaxASCII || !unicode.IsLetter(r) {
+			return false
+		}
+	}
+
+	length := utf8.RuneCountInString(text)
+	if o.MinLength > 0 && length < o.MinLength {
+		return false
+	}
+	if o.MaxLength > 0 && length > o.MaxLength {
+		return false
+	}
+
+	if o.ExcludeProperNouns && unicode.IsUpper([]rune(text)[0]) {
+		return false
+	}
+
+	if o.Pattern != nil && !o.Pattern.MatchString(text) {
+		return false
+	}
+
+	if o.MaxRank > 0 {
+		rank, ok := o.Frequency[strings.ToLower(text)]
+		if !ok || rank > o.MaxRank {
+			return false
+		}
+	}
+
+	return true
+}
+
+// dictionaryHints returns the structural hints of a dictionary word, the
+// first one prefixed with its part of speech when known.
+func dictionaryHints(word *Word, partOfSpeech string) []string {
+	hints := StructuralHints(word)
+	if partOfSpeech != "" {
+		hints[0] = fmt.Sprintf("%s: %s", partOfSpeech, hints[0])
+	}
+	return hints
+}
+
+// ReadFrequencyList reads a word frequency list ordered from most to least
+// common, one word per line; anything after the first field (e.g. a count)
+// is ignored. The returned map holds each word's 1-based rank.
+func ReadFrequencyList(r io.Reader) (map[string]int, error) {
+	ranks := make(map[string]int)
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		word := strings.ToLower(fields[0])
+		if _, ok := ranks[word]; !ok {
+			ranks[word] = len(ranks) + 1
+		}
+	}
+
+	return ranks, scanner.Err()
+}
+
+// LoadDictionary imports the word list at path as a category in the root
+// group, so large vocabularies can be played without writing hints by hand.
+func (l *WordLoader) LoadDictionary(path string, opts DictionaryOptions) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	words, err := ImportDictionary(file, path, opts)
+	if err != nil {
+		return err
+	}
+
+	l.addCategory(RootGroup, &wordFile{category: opts.Category, words: words})
+	return nil
+}
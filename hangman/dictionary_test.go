@@ -0,0 +1,124 @@
+package hangman
+
+import (
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestImportDictionary(t *testing.T) {
+	freqFile, err := os.Open("testdata/frequency.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer freqFile.Close()
+
+	frequency, err := ReadFrequencyList(freqFile)
+	if err != nil {
+		t.Fatalf("ReadFrequencyList() unexpected error = %v", err)
+	}
+	if frequency["the"] != 1 || frequency["banana"] != 5 {
+		t.Fatalf("ReadFrequencyList() = %v, want the=1 banana=5", frequency)
+	}
+
+	tests := []struct {
+		name     string
+		opts     DictionaryOptions
+		expected []string
+	}{
+		{
+			name:     "no filters",
+			opts:     DictionaryOptions{},
+			expected: []string{"apple", "Paris", "banana", "run", "aardvark", "zebra", "quickly"},
+		},
+		{
+			name:     "length range",
+			opts:     DictionaryOptions{MinLength: 5, MaxLength: 6},
+			expected: []string{"apple", "Paris", "banana", "zebra"},
+		},
+		{
+			name:     "exclude proper nouns",
+			opts:     DictionaryOptions{ExcludeProperNouns: true},
+			expected: []string{"apple", "banana", "run", "aardvark", "zebra", "quickly"},
+		},
+		{
+			name:     "pattern",
+			opts:     DictionaryOptions{Pattern: regexp.MustCompile(`^a`)},
+			expected: []string{"apple", "aardvark"},
+		},
+		{
+			name:     "frequency cutoff",
+			opts:     DictionaryOptions{Frequency: frequency, MaxRank: 4},
+			expected: []string{"apple", "run", "zebra"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := os.Open("testdata/dictionary.txt")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer file.Close()
+
+			tt.opts.Category = "Dictionary"
+			words, err := ImportDictionary(file, "testdata/dictionary.txt", tt.opts)
+			if err != nil {
+				t.Fatalf("ImportDictionary() unexpected error = %v", err)
+			}
+
+			texts := make([]string, 0, len(words))
+			for _, word := range words {
+				texts = append(texts, word.Text)
+			}
+			if !reflect.DeepEqual(texts, tt.expected) {
+				t.Errorf("ImportDictionary() = %v, want %v", texts, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDictionaryHints(t *testing.T) {
+	tests := []struct {
+		text         string
+		partOfSpeech string
+		expected     string
+	}{
+		{"apple", "noun", "noun: It has 5 letters"},
+		{"zebra", "", "It has 5 letters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			word := Word{Text: tt.text, Category: "Dictionary"}
+			hints := dictionaryHints(&word, tt.partOfSpeech)
+			if hints[0] != tt.expected {
+				t.Errorf("dictionaryHints()[0] = %q, want %q", hints[0], tt.expected)
+			}
+			if !reflect.DeepEqual(hints[1:], StructuralHints(&word)[1:]) {
+				t.Errorf("dictionaryHints()[1:] = %q, want the other structural hints", hints[1:])
+			}
+		})
+	}
+}
+
+func TestWordLoader_LoadDictionary(t *testing.T) {
+	loader := NewWordLoader()
+	opts := DictionaryOptions{Category: "Dictionary", MinLength: 5, ExcludeProperNouns: true}
+	if err := loader.LoadDictionary("testdata/dictionary.txt", opts); err != nil {
+		t.Fatalf("WordLoader.LoadDictionary() unexpected error = %v", err)
+	}
+
+	words, err := loader.GetWords("Dictionary")
+	if err != nil {
+		t.Fatalf("WordLoader.GetWords() unexpected error = %v", err)
+	}
+	if len(words) != 5 || words[0].Hint != "noun: It has 5 letters" || words[0].Source.Line != 1 {
+		t.Errorf("WordLoader.GetWords() = %+v, want five words with generated hints", words)
+	}
+
+	if err := loader.LoadDictionary("testdata/dictionary.txt", DictionaryOptions{Category: "None", MinLength: 50}); err == nil {
+		t.Error("WordLoader.LoadDictionary() expected error when no word matches")
+	}
+}
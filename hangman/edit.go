@@ -0,0 +1,216 @@
+package hangman
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// WordFile is a plain word file opened for editing. Changes only touch the
+// lines of the affected entries, so the category title, comments, directives
+// and the order of the remaining words are written back unchanged.
+type WordFile struct {
+	Path string
+
+	lines   []string
+	eol     string
+	newline bool
+	perm    os.FileMode
+}
+
+// OpenWordFile reads the word file at path for editing. Encrypted files
+// cannot be edited in place.
+func OpenWordFile(path string) (*WordFile, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if IsEncrypted(data) {
+		return nil, fmt.Errorf("%s is encrypted and cannot be edited", path)
+	}
+
+	text := string(data)
+	f := &WordFile{Path: path, eol: "\n", perm: info.Mode().Perm()}
+	if strings.Contains(text, "\r\n") {
+		f.eol = "\r\n"
+	}
+	f.newline = strings.HasSuffix(text, f.eol)
+	f.lines = strings.Split(strings.TrimSuffix(text, f.eol), f.eol)
+	if len(f.lines) == 0 || f.lines[0] == "" {
+		return nil, fmt.Errorf("%s is missing a category title", path)
+	}
+
+	return f, nil
+}
+
+// Category returns the category title declared on the first line.
+func (f *WordFile) Category() string {
+	return f.lines[0]
+}
+
+// Words returns the valid entries of the file in order.
+func (f *WordFile) Words() []Word {
+	words := []Word{}
+	for i := range f.lines {
+		if word, ok := f.wordAt(i); ok {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// wordAt parses line i, reporting false if it is not a valid word entry.
+func (f *WordFile) wordAt(i int) (Word, bool) {
+	line := f.lines[i]
+	if i == 0 || isCommentLine(line) || strings.HasPrefix(line, "@") {
+		return Word{}, false
+	}
+
+	word, reason := parseWordLine(line)
+	if reason != "" {
+		return Word{}, false
+	}
+	word.Category = f.Category()
+	word.Source = Source{Path: f.Path, Line: i + 1}
+	return word, true
+}
+
+// find returns the line index of the entry whose text matches, ignoring case.
+func (f *WordFile) find(text string) (int, error) {
+	for i := range f.lines {
+		if word, ok := f.wordAt(i); ok && strings.EqualFold(word.Text, text) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("word %q not found in %s", text, f.Path)
+}
+
+// Add appends word after the last entry of the file.
+func (f *WordFile) Add(word Word) error {
+	line, err := FormatWordLine(word)
+	if err != nil {
+		return err
+	}
+	if _, err := f.find(word.Text); err == nil {
+		return fmt.Errorf("word %q already exists in %s", word.Text, f.Path)
+	}
+
+	at := len(f.lines)
+	for i := len(f.lines) - 1; i > 0; i-- {
+		if _, ok := f.wordAt(i); ok {
+			at = i + 1
+			break
+		}
+	}
+	f.lines = slices.Insert(f.lines, at, line)
+	return nil
+}
+
+// Remove deletes the entry whose text matches and returns it. The last word
+// of a file cannot be removed, since LoadFile rejects files without words.
+func (f *WordFile) Remove(text string) (Word, error) {
+	i, err := f.find(text)
+	if err != nil {
+		return Word{}, err
+	}
+	if len(f.Words()) == 1 {
+		return Word{}, fmt.Errorf("cannot remove %q, the last word of %s", text, f.Path)
+	}
+
+	word, _ := f.wordAt(i)
+	f.lines = slices.Delete(f.lines, i, i+1)
+	return word, nil
+}
+
+// Replace rewrites the entry whose text matches with word, keeping its position.
+func (f *WordFile) Replace(text string, word Word) error {
+	i, err := f.find(text)
+	if err != nil {
+		return err
+	}
+	line, err := FormatWordLine(word)
+	if err != nil {
+		return err
+	}
+	if j, err := f.find(word.Text); err == nil && j != i {
+		return fmt.Errorf("word %q already exists in %s", word.Text, f.Path)
+	}
+
+	f.lines[i] = line
+	return nil
+}
+
+// Save writes the file back atomically with its original permissions.
+func (f *WordFile) Save() error {
+	content := strings.Join(f.lines, f.eol)
+	if f.newline {
+		content += f.eol
+	}
+	return writeFileAtomic(f.Path, []byte(content), f.perm)
+}
+
+// FormatWordLine formats word as a "word[|alias...],hint[|hint...][,[tags]]"
+// line, rejecting entries that LoadFile would not read back unchanged.
+func FormatWordLine(word Word) (string, error) {
+	answers := strings.Join(append([]string{word.Text}, word.Aliases...), AliasSeparator)
+	hints := strings.Join(word.Hints(), HintSeparator)
+	if strings.ContainsAny(answers+hints+strings.Join(word.Tags, ""), ",\n") {
+		return "", errors.New("words, hints and tags cannot contain commas or newlines")
+	}
+
+	line := answers + "," + hints
+	if len(word.Tags) > 0 {
+		line += ",[" + strings.Join(word.Tags, " ") + "]"
+	}
+	if isCommentLine(line) || strings.HasPrefix(line, "@") {
+		return "", fmt.Errorf("word %q would be read as a comment or directive", word.Text)
+	}
+
+	parsed, reason := parseWordLine(line)
+	if reason != "" {
+		return "", errors.New(reason)
+	}
+	if parsed.Text != word.Text || !slices.Equal(parsed.Aliases, word.Aliases) {
+		return "", fmt.Errorf("words and aliases cannot contain %q or start or end with spaces", AliasSeparator)
+	}
+	if parsed.Hint != word.Hint || !slices.Equal(parsed.ExtraHints, word.ExtraHints) {
+		return "", fmt.Errorf("hints cannot contain %q or start or end with spaces", HintSeparator)
+	}
+	if !slices.Equal(parsed.Tags, word.Tags) || strings.ContainsAny(strings.Join(word.Tags, ""), "[]") {
+		return "", errors.New("tags cannot contain brackets or spaces")
+	}
+	return line, nil
+}
+
+// CategoryFiles returns the files the category's words were loaded from, in load order.
+func (l *WordLoader) CategoryFiles(category string) []string {
+	files := []string{}
+	for _, word := range l.categoryWords[category] {
+		if word.Source.Path != "" && !slices.Contains(files, word.Source.Path) {
+			files = append(files, word.Source.Path)
+		}
+	}
+	return files
+}
+
+// FindWord returns the word of the category whose text matches, ignoring case.
+func (l *WordLoader) FindWord(category, text string) (*Word, error) {
+	words, err := l.GetWords(category)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range words {
+		if strings.EqualFold(words[i].Text, text) {
+			return &words[i], nil
+		}
+	}
+	return nil, fmt.Errorf("word %q not found in category %q", text, category)
+}
@@ -0,0 +1,150 @@
+package hangman
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestFormatWordLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		word    Word
+		want    string
+		wantErr bool
+	}{
+		{"word and hint", Word{Text: "Cat", Hint: "Kitty"}, "Cat,Kitty", false},
+		{"tags", Word{Text: "Cat", Hint: "Kitty", Tags: []string{"pet", "short"}}, "Cat,Kitty,[pet short]", false},
+		{"empty hint", Word{Text: "Cat"}, "Cat,", false},
+		{"bracket in tag", Word{Text: "Cat", Hint: "Kitty", Tags: []string{"[pet]"}}, "", true},
+		{"aliases", Word{Text: "Grey", Aliases: []string{"Gray"}, Hint: "Colour"}, "Grey|Gray,Colour", false},
+		{"hints", Word{Text: "Owl", Hint: "Bird", ExtraHints: []string{"Hoots"}}, "Owl,Bird|Hoots", false},
+		{"separator in hint", Word{Text: "Owl", Hint: "Bird|Hoots"}, "", true},
+		{"separator in word", Word{Text: "Grey|Gray", Hint: "Colour"}, "", true},
+		{"empty word", Word{Hint: "Kitty"}, "", true},
+		{"comma", Word{Text: "Cat", Hint: "Kitty, cat"}, "", true},
+		{"newline", Word{Text: "Cat\nDog", Hint: "Pets"}, "", true},
+		{"comment", Word{Text: "#Cat", Hint: "Kitty"}, "", true},
+		{"directive", Word{Text: "@order", Hint: "1"}, "", true},
+		{"surrounding spaces", Word{Text: " Cat", Hint: "Kitty"}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatWordLine(tt.word)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FormatWordLine() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("FormatWordLine() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "colors.txt")
+	original := "Colors\n# primary colors\nRed,Blood\n@order 2\nBlue,Sky\n\n# end of list\n"
+	if err := os.WriteFile(path, []byte(original), 0o640); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := OpenWordFile(path)
+	if err != nil {
+		t.Fatalf("OpenWordFile() unexpected error = %v", err)
+	}
+	if file.Category() != "Colors" {
+		t.Errorf("Category() = %q, want %q", file.Category(), "Colors")
+	}
+
+	if err := file.Add(Word{Text: "Green", Hint: "Grass", Tags: []string{"nature"}}); err != nil {
+		t.Fatalf("Add() unexpected error = %v", err)
+	}
+	if err := file.Add(Word{Text: "green", Hint: "Duplicate"}); err == nil {
+		t.Error("Add() duplicate word, want error")
+	}
+	if err := file.Replace("blue", Word{Text: "Blue", Hint: "Ocean"}); err != nil {
+		t.Fatalf("Replace() unexpected error = %v", err)
+	}
+	if err := file.Replace("Blue", Word{Text: "Red", Hint: "Clash"}); err == nil {
+		t.Error("Replace() onto an existing word, want error")
+	}
+	if _, err := file.Remove("Red"); err != nil {
+		t.Fatalf("Remove() unexpected error = %v", err)
+	}
+	if _, err := file.Remove("Purple"); err == nil {
+		t.Error("Remove() missing word, want error")
+	}
+	if err := file.Save(); err != nil {
+		t.Fatalf("Save() unexpected error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "Colors\n# primary colors\n@order 2\nBlue,Ocean\nGreen,Grass,[nature]\n\n# end of list\n"
+	if string(data) != want {
+		t.Errorf("saved file = %q, want %q", data, want)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o640 {
+		t.Errorf("saved file mode = %v, want %v", info.Mode().Perm(), os.FileMode(0o640))
+	}
+
+	loader := NewWordLoader()
+	_, words, err := loader.LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile() of edited file unexpected error = %v", err)
+	}
+	if got := texts(words); !reflect.DeepEqual(got, []string{"Blue", "Green"}) {
+		t.Errorf("LoadFile() words = %v, want [Blue Green]", got)
+	}
+
+	if _, err := file.Remove("Blue"); err != nil {
+		t.Fatalf("Remove() unexpected error = %v", err)
+	}
+	if _, err := file.Remove("Green"); err == nil {
+		t.Error("Remove() last word, want error")
+	}
+}
+
+func TestOpenWordFile_Encrypted(t *testing.T) {
+	data, err := EncryptWords([]byte("Colors\nRed,Blood\n"), Secret{Passphrase: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "colors.txt")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := OpenWordFile(path); err == nil {
+		t.Error("OpenWordFile() encrypted file, want error")
+	}
+}
+
+func TestWordLoader_CategoryFiles(t *testing.T) {
+	loader := NewWordLoader()
+	if err := loader.Load("testdata/valid_data"); err != nil {
+		t.Fatalf("Load() unexpected error = %v", err)
+	}
+
+	want := []string{filepath.Join("testdata", "valid_data", "fruits.txt")}
+	if got := loader.CategoryFiles("Fruits"); !reflect.DeepEqual(got, want) {
+		t.Errorf("CategoryFiles() = %v, want %v", got, want)
+	}
+
+	word, err := loader.FindWord("Fruits", "APPLE")
+	if err != nil {
+		t.Fatalf("FindWord() unexpected error = %v", err)
+	}
+	if word.Text != "Apple" {
+		t.Errorf("FindWord() = %q, want %q", word.Text, "Apple")
+	}
+}
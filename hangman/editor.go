@@ -0,0 +1,277 @@
+package hangman
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// DifficultyLevels are the tags curators use to rate how hard a word is.
+var DifficultyLevels = []string{"easy", "medium", "hard"}
+
+// DifficultyTag returns the word's difficulty level tag, or "" if it has none.
+func (w *Word) DifficultyTag() string {
+	for _, tag := range w.Tags {
+		if slices.Contains(DifficultyLevels, tag) {
+			return tag
+		}
+	}
+	return ""
+}
+
+// Editor is an editing session over the word files of a loader. Changes are
+// kept in memory until Save, and every change can be undone in order.
+type Editor struct {
+	loader  *WordLoader
+	files   map[string]*WordFile
+	dirty   map[string]bool
+	history []editorChange
+}
+
+// editorChange records the content of a file before a change.
+type editorChange struct {
+	file        *WordFile
+	lines       []string
+	description string
+}
+
+// NewEditor starts an editing session over the categories of loader.
+func NewEditor(loader *WordLoader) *Editor {
+	return &Editor{
+		loader: loader,
+		files:  make(map[string]*WordFile),
+		dirty:  make(map[string]bool),
+	}
+}
+
+// Categories returns the categories that were loaded from editable files.
+func (e *Editor) Categories() []string {
+	categories := []string{}
+	for _, category := range e.loader.Categories() {
+		if len(e.loader.CategoryFiles(category)) > 0 {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+// Words returns the current words of a category, including unsaved changes.
+func (e *Editor) Words(category string) ([]Word, error) {
+	files := e.loader.CategoryFiles(category)
+	if len(files) == 0 {
+		return nil, fmt.Errorf("category %q has no editable word files", category)
+	}
+
+	words := []Word{}
+	for _, path := range files {
+		file, err := e.open(path)
+		if err != nil {
+			return nil, err
+		}
+		words = append(words, file.Words()...)
+	}
+	return words, nil
+}
+
+// Search returns every word whose text, hint or tags contain query, ignoring case.
+func (e *Editor) Search(query string) ([]Word, error) {
+	query = strings.ToLower(query)
+	found := []Word{}
+	for _, category := range e.Categories() {
+		words, err := e.Words(category)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, word := range words {
+			haystack := strings.ToLower(word.Text + "\n" + word.Hint + "\n" + strings.Join(word.Tags, " "))
+			if strings.Contains(haystack, query) {
+				found = append(found, word)
+			}
+		}
+	}
+	return found, nil
+}
+
+// Reload returns the current version of a word previously returned by the editor.
+func (e *Editor) Reload(word Word) (Word, error) {
+	file, err := e.open(word.Source.Path)
+	if err != nil {
+		return Word{}, err
+	}
+
+	i, err := file.find(word.Text)
+	if err != nil {
+		return Word{}, err
+	}
+	current, _ := file.wordAt(i)
+	return current, nil
+}
+
+// SetHint changes the hint of a word.
+func (e *Editor) SetHint(word Word, hint string) error {
+	return e.change(word, fmt.Sprintf("hint of %s", word.Text), func(w *Word) {
+		w.Hint = hint
+	})
+}
+
+// SetDifficulty replaces the word's difficulty tag with level, one of
+// DifficultyLevels, or removes it when level is empty.
+func (e *Editor) SetDifficulty(word Word, level string) error {
+	if level != "" && !slices.Contains(DifficultyLevels, level) {
+		return fmt.Errorf("unknown difficulty %q, expected one of %s", level, strings.Join(DifficultyLevels, ", "))
+	}
+
+	return e.change(word, fmt.Sprintf("difficulty of %s", word.Text), func(w *Word) {
+		w.Tags = slices.DeleteFunc(slices.Clone(w.Tags), func(tag string) bool {
+			return slices.Contains(DifficultyLevels, tag)
+		})
+		if level != "" {
+			w.Tags = append(w.Tags, level)
+		}
+	})
+}
+
+// change applies update to the current version of word and records it for Undo.
+func (e *Editor) change(word Word, description string, update func(*Word)) error {
+	current, err := e.Reload(word)
+	if err != nil {
+		return err
+	}
+	file := e.files[current.Source.Path]
+	before := slices.Clone(file.lines)
+
+	update(&current)
+	if err := file.Replace(word.Text, current); err != nil {
+		return err
+	}
+
+	e.history = append(e.history, editorChange{file: file, lines: before, description: description})
+	e.dirty[file.Path] = true
+	return nil
+}
+
+// CanUndo reports whether there is a change to undo.
+func (e *Editor) CanUndo() bool {
+	return len(e.history) > 0
+}
+
+// LastChange describes the change Undo would revert.
+func (e *Editor) LastChange() string {
+	if len(e.history) == 0 {
+		return ""
+	}
+	return e.history[len(e.history)-1].description
+}
+
+// Undo reverts the most recent change, including changes already saved,
+// and returns its description.
+func (e *Editor) Undo() (string, error) {
+	if len(e.history) == 0 {
+		return "", errors.New("nothing to undo")
+	}
+
+	last := e.history[len(e.history)-1]
+	e.history = e.history[:len(e.history)-1]
+	last.file.lines = last.lines
+	e.dirty[last.file.Path] = true
+	return last.description, nil
+}
+
+// Unsaved returns the number of files with unsaved changes.
+func (e *Editor) Unsaved() int {
+	return len(e.dirty)
+}
+
+// Save writes every changed file. Files that belong to a word pack get their
+// checksum in pack.json updated; the pack signature is removed since it no
+// longer matches and the pack has to be signed again.
+func (e *Editor) Save() error {
+	paths := make([]string, 0, len(e.dirty))
+	for path := range e.dirty {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	for _, path := range paths {
+		if err := e.files[path].Save(); err != nil {
+			return err
+		}
+		if err := updatePackChecksum(path); err != nil {
+			return err
+		}
+		delete(e.dirty, path)
+	}
+	return nil
+}
+
+// open returns the editable file at path, reading it on first use.
+func (e *Editor) open(path string) (*WordFile, error) {
+	if file, ok := e.files[path]; ok {
+		return file, nil
+	}
+
+	file, err := OpenWordFile(path)
+	if err != nil {
+		return nil, err
+	}
+	e.files[path] = file
+	return file, nil
+}
+
+// updatePackChecksum refreshes the checksum of path in the manifest of the
+// pack containing it, if any.
+func updatePackChecksum(path string) error {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+
+	for dir := filepath.Dir(abs); ; dir = filepath.Dir(dir) {
+		manifestPath := filepath.Join(dir, ManifestFile)
+		if _, err := os.Stat(manifestPath); err == nil {
+			return refreshChecksum(dir, abs)
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		if dir == filepath.Dir(dir) {
+			return nil
+		}
+	}
+}
+
+// refreshChecksum updates the checksum of the file at abs in the manifest of the pack at dir.
+func refreshChecksum(dir, abs string) error {
+	manifest, err := ReadManifest(os.DirFS(dir))
+	if err != nil {
+		return err
+	}
+
+	rel, err := filepath.Rel(dir, abs)
+	if err != nil {
+		return err
+	}
+	name := filepath.ToSlash(rel)
+	if _, ok := manifest.SHA256[name]; !ok {
+		return nil
+	}
+
+	data, err := os.ReadFile(abs)
+	if err != nil {
+		return err
+	}
+	manifest.SHA256[name] = Checksum(data)
+	if manifest.Signature != "" {
+		logrus.Warnf("pack %s was modified and is no longer signed; sign it again with `hangman pack sign`", manifest.Name)
+		manifest.Signature = ""
+	}
+
+	return writeManifest(dir, manifest)
+}
@@ -0,0 +1,137 @@
+package hangman
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func testEditor(t *testing.T) (*Editor, string) {
+	t.Helper()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "animals.txt")
+	if err := os.WriteFile(path, []byte("Animals\n# farm\nCat,Kitty,[pet]\nDog,Puppy\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	loader := NewWordLoader()
+	if err := loader.Load(dir); err != nil {
+		t.Fatalf("Load() unexpected error = %v", err)
+	}
+	return NewEditor(loader), path
+}
+
+func TestEditor(t *testing.T) {
+	editor, path := testEditor(t)
+
+	words, err := editor.Words("Animals")
+	if err != nil {
+		t.Fatalf("Words() unexpected error = %v", err)
+	}
+	if err := editor.SetHint(words[0], "Meows"); err != nil {
+		t.Fatalf("SetHint() unexpected error = %v", err)
+	}
+	if err := editor.SetDifficulty(words[1], "hard"); err != nil {
+		t.Fatalf("SetDifficulty() unexpected error = %v", err)
+	}
+	if err := editor.SetDifficulty(words[1], "easy"); err != nil {
+		t.Fatalf("SetDifficulty() unexpected error = %v", err)
+	}
+	if err := editor.SetDifficulty(words[1], "impossible"); err == nil {
+		t.Error("SetDifficulty() unknown level, want error")
+	}
+	if err := editor.SetHint(words[0], "Bad, hint"); err == nil {
+		t.Error("SetHint() with a comma, want error")
+	}
+
+	dog, err := editor.Reload(words[1])
+	if err != nil {
+		t.Fatalf("Reload() unexpected error = %v", err)
+	}
+	if got := dog.DifficultyTag(); got != "easy" {
+		t.Errorf("DifficultyTag() = %q, want %q", got, "easy")
+	}
+
+	found, err := editor.Search("meow")
+	if err != nil {
+		t.Fatalf("Search() unexpected error = %v", err)
+	}
+	if got := texts(found); !reflect.DeepEqual(got, []string{"Cat"}) {
+		t.Errorf("Search() = %v, want [Cat]", got)
+	}
+
+	if got := editor.LastChange(); got != "difficulty of Dog" {
+		t.Errorf("LastChange() = %q, want %q", got, "difficulty of Dog")
+	}
+	if _, err := editor.Undo(); err != nil {
+		t.Fatalf("Undo() unexpected error = %v", err)
+	}
+	if editor.Unsaved() != 1 {
+		t.Errorf("Unsaved() = %d, want 1", editor.Unsaved())
+	}
+	if err := editor.Save(); err != nil {
+		t.Fatalf("Save() unexpected error = %v", err)
+	}
+	if editor.Unsaved() != 0 {
+		t.Errorf("Unsaved() after Save() = %d, want 0", editor.Unsaved())
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Animals\n# farm\nCat,Meows,[pet]\nDog,Puppy,[hard]\n"; string(data) != want {
+		t.Errorf("saved file = %q, want %q", data, want)
+	}
+
+	for editor.CanUndo() {
+		if _, err := editor.Undo(); err != nil {
+			t.Fatalf("Undo() unexpected error = %v", err)
+		}
+	}
+	if _, err := editor.Undo(); err == nil {
+		t.Error("Undo() with empty history, want error")
+	}
+	if err := editor.Save(); err != nil {
+		t.Fatalf("Save() unexpected error = %v", err)
+	}
+	data, _ = os.ReadFile(path)
+	if want := "Animals\n# farm\nCat,Kitty,[pet]\nDog,Puppy\n"; string(data) != want {
+		t.Errorf("file after undoing everything = %q, want %q", data, want)
+	}
+}
+
+func TestEditor_SavePack(t *testing.T) {
+	dir := t.TempDir()
+	writePackDir(t, dir)
+	_, private := testKeys(t)
+	if _, err := SignPack(dir, private); err != nil {
+		t.Fatalf("SignPack() unexpected error = %v", err)
+	}
+
+	loader := NewWordLoader()
+	if err := loader.LoadPack(dir); err != nil {
+		t.Fatalf("LoadPack() unexpected error = %v", err)
+	}
+
+	editor := NewEditor(loader)
+	words, err := editor.Words("Gulls")
+	if err != nil {
+		t.Fatalf("Words() unexpected error = %v", err)
+	}
+	if err := editor.SetHint(words[0], "Loves seaside chips"); err != nil {
+		t.Fatalf("SetHint() unexpected error = %v", err)
+	}
+	if err := editor.Save(); err != nil {
+		t.Fatalf("Save() unexpected error = %v", err)
+	}
+
+	manifest, err := VerifyPack(dir, PackPolicy{})
+	if err != nil {
+		t.Fatalf("VerifyPack() after edit unexpected error = %v", err)
+	}
+	if manifest.Signature != "" {
+		t.Error("Signature after edit is set, want it removed")
+	}
+}
@@ -0,0 +1,277 @@
+package hangman
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+	"github.com/sirupsen/logrus"
+)
+
+// editorListSize is the number of entries shown at once in editor lists.
+const editorListSize = 15
+
+// editorAction identifies what an editor menu entry does when selected.
+type editorAction int
+
+const (
+	editorActionSearch editorAction = iota
+	editorActionCategory
+	editorActionUndo
+	editorActionSave
+	editorActionQuit
+)
+
+// editorItem is a single entry of the editor menu.
+type editorItem struct {
+	label  string
+	action editorAction
+	target string
+}
+
+// menuItems builds the editor's main menu entries.
+func (e *Editor) menuItems() []editorItem {
+	items := []editorItem{{label: "🔍 Search words", action: editorActionSearch}}
+	for _, category := range e.Categories() {
+		words, _ := e.Words(category)
+		items = append(items, editorItem{label: fmt.Sprintf("📂 %s (%d)", category, len(words)), action: editorActionCategory, target: category})
+	}
+	if e.CanUndo() {
+		items = append(items, editorItem{label: fmt.Sprintf("↩️  Undo %s", e.LastChange()), action: editorActionUndo})
+	}
+	if e.Unsaved() > 0 {
+		items = append(items, editorItem{label: fmt.Sprintf("💾 Save %d file(s)", e.Unsaved()), action: editorActionSave})
+	}
+	return append(items, editorItem{label: "❌ Quit", action: editorActionQuit})
+}
+
+// Run starts the interactive editor: browse categories, search words, edit
+// hints and difficulty tags with a preview of the masked answer, undo
+// changes and save them back to the word files.
+func (e *Editor) Run() error {
+	for {
+		items := e.menuItems()
+		labels := make([]string, 0, len(items))
+		for _, item := range items {
+			labels = append(labels, item.label)
+		}
+
+		prompt := promptui.Select{
+			Label:    "Word Editor",
+			Items:    labels,
+			Size:     editorListSize,
+			Searcher: labelSearcher(labels),
+		}
+
+		item := editorItem{action: editorActionQuit}
+		idx, _, err := prompt.Run()
+		if err == nil {
+			item = items[idx]
+		} else if !errors.Is(err, promptui.ErrInterrupt) && !errors.Is(err, promptui.ErrEOF) {
+			return fmt.Errorf("prompt failed: %w", err)
+		}
+
+		switch item.action {
+		case editorActionSearch:
+			e.searchWords()
+		case editorActionCategory:
+			words, err := e.Words(item.target)
+			if err != nil {
+				logrus.Error(err)
+				continue
+			}
+			e.browseWords(item.target, words)
+		case editorActionUndo:
+			if description, err := e.Undo(); err == nil {
+				logrus.Infof("undid %s", description)
+			}
+		case editorActionSave:
+			if err := e.Save(); err != nil {
+				logrus.WithError(err).Error("failed to save")
+			} else {
+				logrus.Info("saved")
+			}
+		case editorActionQuit:
+			if e.confirmQuit() {
+				return nil
+			}
+		}
+	}
+}
+
+// confirmQuit asks before discarding unsaved changes.
+func (e *Editor) confirmQuit() bool {
+	if e.Unsaved() == 0 {
+		return true
+	}
+
+	prompt := promptui.Prompt{
+		Label:     fmt.Sprintf("Discard unsaved changes to %d file(s)", e.Unsaved()),
+		IsConfirm: true,
+	}
+	_, err := prompt.Run()
+	return err == nil
+}
+
+// searchWords asks for a query and browses the matching words.
+func (e *Editor) searchWords() {
+	prompt := promptui.Prompt{Label: "Search"}
+	query, err := prompt.Run()
+	if err != nil || query == "" {
+		return
+	}
+
+	words, err := e.Search(query)
+	if err != nil {
+		logrus.Error(err)
+		return
+	}
+	if len(words) == 0 {
+		logrus.Infof("no words match %q", query)
+		return
+	}
+
+	e.browseWords(fmt.Sprintf("Search: %s", query), words)
+}
+
+// browseWords lists words until the user goes back.
+func (e *Editor) browseWords(title string, words []Word) {
+	cursor := 0
+	for {
+		labels := make([]string, 0, len(words)+1)
+		for i := range words {
+			if current, err := e.Reload(words[i]); err == nil {
+				words[i] = current
+			}
+			labels = append(labels, wordLabel(&words[i]))
+		}
+		labels = append(labels, "⬅️  Back")
+
+		prompt := promptui.Select{
+			Label:     title,
+			Items:     labels,
+			Size:      editorListSize,
+			CursorPos: cursor,
+			Searcher:  labelSearcher(labels),
+		}
+
+		idx, _, err := prompt.Run()
+		if err != nil || idx == len(words) {
+			return
+		}
+
+		cursor = idx
+		e.editWord(words[idx])
+	}
+}
+
+// editWord shows a word with its masked preview and offers the edit actions.
+func (e *Editor) editWord(word Word) {
+	for {
+		current, err := e.Reload(word)
+		if err != nil {
+			logrus.Error(err)
+			return
+		}
+		word = current
+
+		fmt.Println(wordDetails(&word))
+
+		prompt := promptui.Select{
+			Label: word.Text,
+			Items: []string{"✏️  Edit hint", "🏷️  Set difficulty", "⬅️  Back"},
+		}
+		idx, _, err := prompt.Run()
+		if err != nil {
+			return
+		}
+
+		switch idx {
+		case 0:
+			err = e.editHint(word)
+		case 1:
+			err = e.editDifficulty(word)
+		default:
+			return
+		}
+		if err != nil {
+			logrus.Error(err)
+		}
+	}
+}
+
+// editHint prompts for a new hint, pre-filled with the current one.
+func (e *Editor) editHint(word Word) error {
+	prompt := promptui.Prompt{
+		Label:     "Hint",
+		Default:   word.Hint,
+		AllowEdit: true,
+		Validate: func(hint string) error {
+			_, err := FormatWordLine(Word{Text: word.Text, Hint: hint, Tags: word.Tags})
+			return err
+		},
+	}
+
+	hint, err := prompt.Run()
+	if err != nil || hint == word.Hint {
+		return nil
+	}
+	return e.SetHint(word, hint)
+}
+
+// editDifficulty prompts for one of DifficultyLevels, or none.
+func (e *Editor) editDifficulty(word Word) error {
+	items := append([]string{"none"}, DifficultyLevels...)
+	prompt := promptui.Select{
+		Label: fmt.Sprintf("Difficulty (estimated %d/%d)", word.Meta().Difficulty, MaxDifficulty),
+		Items: items,
+	}
+
+	idx, level, err := prompt.Run()
+	if err != nil {
+		return nil
+	}
+	if idx == 0 {
+		level = ""
+	}
+	if level == word.DifficultyTag() {
+		return nil
+	}
+	return e.SetDifficulty(word, level)
+}
+
+// wordLabel formats a word as a list entry.
+func wordLabel(word *Word) string {
+	label := fmt.Sprintf("%s — %s", word.Text, word.Hint)
+	if len(word.Tags) > 0 {
+		label += fmt.Sprintf(" [%s]", strings.Join(word.Tags, " "))
+	}
+	return label
+}
+
+// wordDetails describes a word as the player would see it.
+func wordDetails(word *Word) string {
+	builder := new(strings.Builder)
+	fmt.Fprintf(builder, "Word:       %s\n", word.Text)
+	fmt.Fprintf(builder, "Preview:    %s\n", strings.Join(word.PreAnswer(), " "))
+	fmt.Fprintf(builder, "Hint:       %s\n", word.Hint)
+	for i, hint := range word.ExtraHints {
+		fmt.Fprintf(builder, "Hint %d:     %s\n", i+2, hint)
+	}
+	fmt.Fprintf(builder, "Category:   %s\n", word.Category)
+	fmt.Fprintf(builder, "Tags:       %s\n", strings.Join(word.Tags, " "))
+	fmt.Fprintf(builder, "Difficulty: estimated %d/%d", word.Meta().Difficulty, MaxDifficulty)
+	if tag := word.DifficultyTag(); tag != "" {
+		fmt.Fprintf(builder, ", tagged %s", tag)
+	}
+	fmt.Fprintf(builder, "\nSource:     %s:%d", word.Source.Path, word.Source.Line)
+	return builder.String()
+}
+
+// labelSearcher filters select entries by a case-insensitive substring.
+func labelSearcher(labels []string) func(string, int) bool {
+	return func(input string, index int) bool {
+		return strings.Contains(strings.ToLower(labels[index]), strings.ToLower(input))
+	}
+}
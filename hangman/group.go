@@ -0,0 +1,112 @@
+package hangman
+
+import (
+	"errors"
+	"math/rand"
+	"path"
+	"strings"
+)
+
+// RootGroup is the group holding categories loaded from the top of a data directory.
+const RootGroup = ""
+
+// groupOf returns the group of a word file: the directory of file relative
+// to root, or RootGroup for files directly inside root. Both are slash-separated
+// fs.FS names.
+func groupOf(root, file string) string {
+	dir := path.Dir(file)
+	if dir == root || dir == "." {
+		return RootGroup
+	}
+	if root != "." {
+		dir = strings.TrimPrefix(dir, root+"/")
+	}
+	return dir
+}
+
+// inGroup reports whether member is group itself or nested below it.
+func inGroup(member, group string) bool {
+	return group == RootGroup || member == group || strings.HasPrefix(member, group+"/")
+}
+
+// addGroup registers group and all of its ancestors.
+func (l *WordLoader) addGroup(group string) {
+	for group != RootGroup {
+		for _, known := range l.groups {
+			if known == group {
+				return
+			}
+		}
+		l.groups = append(l.groups, group)
+		group = ParentGroup(group)
+	}
+}
+
+// GroupName returns the last path element of group, used as its display name.
+func GroupName(group string) string {
+	return path.Base(group)
+}
+
+// ParentGroup returns the group containing group, or RootGroup at the top level.
+func ParentGroup(group string) string {
+	parent := path.Dir(group)
+	if parent == "." {
+		return RootGroup
+	}
+	return parent
+}
+
+// SubGroups returns the groups directly below group, in load order.
+func (l *WordLoader) SubGroups(group string) []string {
+	children := []string{}
+	for _, known := range l.groups {
+		if known != group && ParentGroup(known) == group {
+			children = append(children, known)
+		}
+	}
+	return children
+}
+
+// CategoriesIn returns the categories directly inside group, sorted
+// according to the loader's CategoryOrder.
+func (l *WordLoader) CategoriesIn(group string) []string {
+	categories := []string{}
+	for _, category := range l.categories {
+		if l.categoryGroup[category] == group {
+			categories = append(categories, category)
+		}
+	}
+	return l.sortCategories(categories)
+}
+
+// CategoryGroup returns the group a category was loaded into.
+func (l *WordLoader) CategoryGroup(category string) string {
+	return l.categoryGroup[category]
+}
+
+// GroupWords returns the words of every category in group and its subgroups.
+func (l *WordLoader) GroupWords(group string) ([]Word, error) {
+	words := []Word{}
+	for _, category := range l.categories {
+		if inGroup(l.categoryGroup[category], group) {
+			words = append(words, l.categoryWords[category]...)
+		}
+	}
+
+	if len(words) == 0 {
+		return nil, errors.New("no words available in this group")
+	}
+
+	return words, nil
+}
+
+// RandomGroupWord retrieves a random word from any category in group or its subgroups.
+func (l *WordLoader) RandomGroupWord(group string) (*Word, error) {
+	words, err := l.GroupWords(group)
+	if err != nil {
+		return nil, err
+	}
+
+	index := rand.Intn(len(words))
+	return &words[index], nil
+}
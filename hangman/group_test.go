@@ -0,0 +1,120 @@
+package hangman
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGroupOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		root     string
+		file     string
+		expected string
+	}{
+		{"top level", "data", "data/animal.txt", RootGroup},
+		{"one level", "data", "data/sports/terms.txt", "sports"},
+		{"nested", "data", "data/sports/football/epl.txt", "sports/football"},
+		{"root is file directory", "data/sports", "data/sports/terms.txt", RootGroup},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := groupOf(tt.root, tt.file)
+			if result != tt.expected {
+				t.Errorf("groupOf(%q, %q) = %q; want %q", tt.root, tt.file, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParentGroup(t *testing.T) {
+	tests := []struct {
+		group    string
+		expected string
+	}{
+		{"sports/football", "sports"},
+		{"sports", RootGroup},
+		{RootGroup, RootGroup},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.group, func(t *testing.T) {
+			if result := ParentGroup(tt.group); result != tt.expected {
+				t.Errorf("ParentGroup(%q) = %q; want %q", tt.group, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWordLoader_Groups(t *testing.T) {
+	loader := NewWordLoader()
+	if err := loader.Load("testdata/groups"); err != nil {
+		t.Fatalf("WordLoader.Load() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		group          string
+		wantGroups     []string
+		wantCategories []string
+		wantWords      int
+	}{
+		{
+			name:           "root",
+			group:          RootGroup,
+			wantGroups:     []string{"sports"},
+			wantCategories: []string{"Animals"},
+			wantWords:      5,
+		},
+		{
+			name:           "sports",
+			group:          "sports",
+			wantGroups:     []string{"sports/football", "sports/tennis"},
+			wantCategories: []string{"Sports Terms"},
+			wantWords:      4,
+		},
+		{
+			name:           "football",
+			group:          "sports/football",
+			wantGroups:     []string{},
+			wantCategories: []string{"EPL Teams"},
+			wantWords:      2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if groups := loader.SubGroups(tt.group); !reflect.DeepEqual(groups, tt.wantGroups) {
+				t.Errorf("WordLoader.SubGroups() = %v, want %v", groups, tt.wantGroups)
+			}
+			if categories := loader.CategoriesIn(tt.group); !reflect.DeepEqual(categories, tt.wantCategories) {
+				t.Errorf("WordLoader.CategoriesIn() = %v, want %v", categories, tt.wantCategories)
+			}
+
+			words, err := loader.GroupWords(tt.group)
+			if err != nil {
+				t.Fatalf("WordLoader.GroupWords() unexpected error = %v", err)
+			}
+			if len(words) != tt.wantWords {
+				t.Errorf("WordLoader.GroupWords() returned %v words, want %v", len(words), tt.wantWords)
+			}
+		})
+	}
+
+	if group := loader.CategoryGroup("EPL Teams"); group != "sports/football" {
+		t.Errorf("WordLoader.CategoryGroup() = %q, want sports/football", group)
+	}
+
+	word, err := loader.RandomGroupWord("sports/tennis")
+	if err != nil {
+		t.Fatalf("WordLoader.RandomGroupWord() unexpected error = %v", err)
+	}
+	if word.Text != "Federer" || word.Category != "Tennis Players" {
+		t.Errorf("WordLoader.RandomGroupWord() = %+v, want Federer from Tennis Players", word)
+	}
+
+	if _, err := loader.GroupWords("missing"); err == nil {
+		t.Error("WordLoader.GroupWords() expected error for unknown group")
+	}
+}
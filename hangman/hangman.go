@@ -3,7 +3,13 @@ package hangman
 import (
 	"errors"
 	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/manifoldco/promptui"
 	"github.com/sirupsen/logrus"
@@ -23,37 +29,205 @@ const (
 	GameStateWin     = GameState("win")
 	GameStateLose    = GameState("lose")
 	GameStateQuit    = GameState("quit")
+	// GameStateSave saves the round in progress, then resumes it.
+	GameStateSave = GameState("save")
+	// GameStateAbandon forfeits the round in progress and goes back to the menu.
+	GameStateAbandon = GameState("abandoned")
+	// GameStateStats shows the session statistics, then resumes the round.
+	GameStateStats = GameState("stats")
 )
 
+// Config holds the settings used to create a Hangman instance.
+type Config struct {
+	DataDir       string
+	LoadMode      LoadMode
+	CategoryOrder CategoryOrder
+	// FallbackFS is loaded instead of DataDir when DataDir does not exist.
+	FallbackFS fs.FS
+	// PacksDir holds installed word packs loaded alongside DataDir; empty disables packs.
+	PacksDir string
+	// PackPolicy decides which installed packs are trusted.
+	PackPolicy PackPolicy
+	// Secret decrypts encrypted word files.
+	Secret Secret
+	// Sources are extra catalogs, such as databases or HTTP endpoints, merged into the menu.
+	Sources []WordSource
+	// Dictionaries are plain word lists imported as categories with generated hints.
+	Dictionaries []Dictionary
+	// Scoring decides points per guess and the cost of extra hints.
+	Scoring Scoring
+	// SaveFile is where :save writes the round in progress; empty disables saving.
+	SaveFile string
+	// BatchGuesses accepts several letters in one entry, e.g. "aeiou", in
+	// every mode. Turn it off to force one letter per guess.
+	BatchGuesses bool
+	// Timing puts every round under time pressure; the zero value is untimed.
+	Timing Timing
+	// Clock times the rounds; nil uses the wall clock.
+	Clock Clock
+	// TimeAttack replaces classic rounds with time-attack runs when its
+	// duration is set.
+	TimeAttack TimeAttack
+	// Survival replaces classic rounds with survival runs when it has lives.
+	Survival Survival
+	// Marathon replaces classic rounds with marathons when enabled.
+	Marathon Marathon
+	// LeaderboardFile ranks classic rounds and every kind of run separately;
+	// empty disables the leaderboard.
+	LeaderboardFile string
+	// PlaysFile keeps the play counts used by OrderMostPlayed across
+	// sessions; empty keeps them in memory only.
+	PlaysFile string
+}
+
+// DefaultConfig returns the configuration used when no options are given.
+func DefaultConfig() Config {
+	return Config{
+		DataDir:       DefaultDataDir,
+		LoadMode:      LoadModeStrict,
+		CategoryOrder: OrderFile,
+		Scoring:       DefaultScoring(),
+		BatchGuesses:  true,
+		Survival:      Survival{Refill: DefaultSurvivalRefill, RampEvery: DefaultSurvivalRampEvery},
+	}
+}
+
 type Hangman struct {
 	WordLoader *WordLoader
 
 	gameState            GameState
 	additionalMaxGuesses int
+	scoring              Scoring
+	saveFile             string
+	saved                bool
+	batchGuesses         bool
+	timing               Timing
+	clock                Clock
+	timeAttack           TimeAttack
+	attack               *attackRun
+	survival             Survival
+	survivalRun          *survivalRun
+	marathon             Marathon
+	marathonRun          *marathonRun
+	leaderboardFile      string
+	playsFile            string
+	results              []RoundResult
 }
 
-// NewHangman creates a new Hangman game instance with default settings.
-func NewHangman() (*Hangman, error) {
+// NewHangman creates a new Hangman game instance from the given configuration.
+func NewHangman(cfg Config) (*Hangman, error) {
+	switch cfg.CategoryOrder {
+	case OrderFile, OrderAlphabetical, OrderExplicit, OrderMostPlayed:
+	default:
+		return nil, fmt.Errorf("unknown category order %q", cfg.CategoryOrder)
+	}
+
 	wordLoader := NewWordLoader()
-	if err := wordLoader.Load(DefaultDataDir); err != nil {
+	wordLoader.SetMode(cfg.LoadMode)
+	wordLoader.SetOrder(cfg.CategoryOrder)
+	wordLoader.SetPackPolicy(cfg.PackPolicy)
+	wordLoader.SetSecret(cfg.Secret)
+	if cfg.PlaysFile != "" {
+		plays, err := ReadPlayCounts(cfg.PlaysFile)
+		if err != nil {
+			return nil, err
+		}
+		wordLoader.SetPlayCounts(plays)
+	}
+	if cfg.PackPolicy.RequireSigned && (len(cfg.Sources) > 0 || len(cfg.Dictionaries) > 0) {
+		return nil, errors.New("signed packs are required, but database, HTTP and dictionary sources cannot be signed")
+	}
+	if err := loadWords(wordLoader, cfg); err != nil {
 		return nil, err
 	}
+	if cfg.PacksDir != "" {
+		if err := NewPackStore(cfg.PacksDir).LoadInto(wordLoader); err != nil {
+			return nil, err
+		}
+	}
+	for _, src := range cfg.Sources {
+		if err := wordLoader.LoadSource(src); err != nil {
+			return nil, err
+		}
+	}
+	for _, dict := range cfg.Dictionaries {
+		if err := wordLoader.LoadDictionary(dict.Path, dict.Options); err != nil {
+			return nil, err
+		}
+	}
+	if len(wordLoader.Categories()) == 0 {
+		return nil, errors.New("no word categories could be loaded")
+	}
+	modes := 0
+	for _, enabled := range []bool{cfg.TimeAttack.Duration > 0, cfg.Survival.Lives > 0, cfg.Marathon.Enabled} {
+		if enabled {
+			modes++
+		}
+	}
+	if modes > 1 {
+		return nil, errors.New("time attack, survival and marathon cannot be combined")
+	}
+	if cfg.Clock == nil {
+		cfg.Clock = SystemClock{}
+	}
 
 	return &Hangman{
 		WordLoader:           wordLoader,
 		gameState:            GameStatePending,
 		additionalMaxGuesses: DefaultAdditionalGuesses,
+		scoring:              cfg.Scoring,
+		saveFile:             cfg.SaveFile,
+		batchGuesses:         cfg.BatchGuesses,
+		timing:               cfg.Timing,
+		clock:                cfg.Clock,
+		timeAttack:           cfg.TimeAttack,
+		survival:             cfg.Survival,
+		marathon:             cfg.Marathon,
+		leaderboardFile:      cfg.LeaderboardFile,
+		playsFile:            cfg.PlaysFile,
 	}, nil
 }
 
+// loadWords loads cfg.DataDir, or cfg.FallbackFS when the directory is missing.
+// When signed packs are required, cfg.DataDir is only loaded if it is a pack
+// itself, verified like installed packs.
+func loadWords(wordLoader *WordLoader, cfg Config) error {
+	if cfg.PackPolicy.RequireSigned {
+		if _, err := os.Stat(filepath.Join(cfg.DataDir, ManifestFile)); err != nil {
+			logrus.Warnf("%s is not a signed pack, skipped because signed packs are required", cfg.DataDir)
+			return nil
+		}
+		return wordLoader.LoadPack(cfg.DataDir)
+	}
+
+	if _, err := os.Stat(cfg.DataDir); errors.Is(err, fs.ErrNotExist) && cfg.FallbackFS != nil {
+		logrus.Infof("%s not found, using the built-in word pack", cfg.DataDir)
+		return wordLoader.LoadFS(cfg.FallbackFS, ".")
+	}
+	return wordLoader.Load(cfg.DataDir)
+}
+
 // Start runs the main game loop until the user quits.
 func (h *Hangman) Start() {
-	var game *HangmanGame
+	game, state, err := h.startConfiguredRun()
+	if err != nil {
+		logrus.WithError(err).Error("failed to start the run")
+		return
+	}
+	h.gameState = state
 	for {
 		switch h.gameState {
 		case GameStatePending:
 			var err error
-			game, h.gameState, err = h.createGame()
+			if h.attack != nil {
+				game, h.gameState, err = h.nextAttackRound()
+			} else if h.survivalRun != nil {
+				game, h.gameState, err = h.nextSurvivalRound()
+			} else if h.marathonRun != nil {
+				game, h.gameState, err = h.nextMarathonRound()
+			} else {
+				game, h.gameState, err = h.createGame()
+			}
 			if err != nil {
 				logrus.WithError(err).Error("failed to create game")
 				h.gameState = GameStateQuit
@@ -66,56 +240,362 @@ func (h *Hangman) Start() {
 				h.gameState = GameStateQuit
 			}
 		case GameStateWin:
+			h.finishRound(game)
 			logrus.Info("🎉 You win!")
 			h.gameState = GameStatePending
 		case GameStateLose:
+			h.finishRound(game)
 			logrus.Info("😢 You lose!")
 			h.gameState = GameStatePending
+		case GameStateSave:
+			if h.attack != nil || h.survivalRun != nil {
+				logrus.Warn("only classic rounds and marathons can be saved")
+			} else if err := h.saveGame(game); err != nil {
+				logrus.WithError(err).Error("failed to save the game")
+			} else {
+				logrus.Infof("💾 Saved to %s, resume it from the menu", h.saveFile)
+			}
+			h.gameState = GameStatePlaying
+		case GameStateAbandon:
+			h.abandonRound(game)
+			h.gameState = GameStatePending
+		case GameStateStats:
+			logrus.Info("📊 ", Summarize(h.results))
+			h.gameState = GameStatePlaying
 		case GameStateQuit:
+			h.quit()
 			logrus.Info("👋 Quit...")
 			return
 		}
 	}
 }
 
-// createGame displays the category menu and creates a new game instance.
-func (h *Hangman) createGame() (*HangmanGame, GameState, error) {
-	categories := h.WordLoader.Categories()
-	items := make([]string, 0)
-	for _, category := range categories {
-		items = append(items, fmt.Sprintf("📂 %s", category))
+// finishRound records the result of a finished round and shows its summary.
+// A save of the round is removed so it cannot be resumed and scored again.
+func (h *Hangman) finishRound(game *HangmanGame) {
+	h.discardSave()
+	result := game.Result()
+	h.results = append(h.results, result)
+	logrus.Info(result.Summary())
+	if h.survivalRun != nil {
+		h.endSurvivalRound(result, game.remaining)
+	}
+	if h.marathonRun != nil {
+		logrus.Infof("🏃 %s played", h.marathonRun.progress())
+	}
+	if h.attack != nil || h.survivalRun != nil || h.marathonRun != nil || result.State == GameStateAbandon {
+		return
 	}
-	items = append(items, "❌ Quit")
 
-	prompt := promptui.Select{
-		Label: "Hangman Menu - Select Category",
-		Items: items,
+	entry := LeaderboardEntry{
+		Score:      result.Score,
+		Rounds:     1,
+		Categories: []string{result.Word.Category},
+		PlayedAt:   h.clock.Now(),
 	}
+	if result.State == GameStateWin {
+		entry.Solved, entry.Average = 1, result.Duration
+	}
+	h.recordScore(ModeClassic, entry)
+}
+
+// runEnd is why a run ended.
+type runEnd string
+
+const (
+	// runOver is the natural end of a run: its timer or lives ran out, or
+	// every word was played.
+	runOver runEnd = "over"
+	// runStopped is a run ended early by a forfeit or by quitting.
+	runStopped runEnd = "stopped"
+)
+
+// abandonRound records a forfeited round and ends the run it belongs to, so
+// the next round starts from the menu.
+func (h *Hangman) abandonRound(game *HangmanGame) {
+	h.finishRound(game)
+	logrus.Info("🏳️ Round abandoned")
+	h.finishRun(runStopped)
+}
+
+// quit ends the run in progress when the game is quit, so it is still ranked.
+// A marathon saved with :save is only paused and is left to be resumed.
+func (h *Hangman) quit() {
+	if h.saved {
+		return
+	}
+	h.finishRun(runStopped)
+}
+
+// finishRun ends the run in progress, if any, and ranks what was played.
+func (h *Hangman) finishRun(end runEnd) {
+	switch {
+	case h.attack != nil:
+		h.finishTimeAttack(end)
+	case h.survivalRun != nil:
+		h.finishSurvival(end)
+	case h.marathonRun != nil:
+		h.finishMarathon(end)
+	}
+}
+
+// runMode reports whether the menu starts runs instead of classic rounds.
+func (h *Hangman) runMode() bool {
+	return h.timeAttack.Duration > 0 || h.survival.Lives > 0 || h.marathon.Enabled
+}
 
-	idx, _, err := prompt.Run()
+// startRun starts a run on the words of the chosen category or group.
+func (h *Hangman) startRun(words []Word, categories []string) (*HangmanGame, GameState, error) {
+	if h.survival.Lives > 0 {
+		h.startSurvival(words, categories)
+		return h.nextSurvivalRound()
+	}
+	if h.marathon.Enabled {
+		h.startMarathon(words, categories)
+		return h.nextMarathonRound()
+	}
+	h.startTimeAttack(words, categories)
+	return h.nextAttackRound()
+}
+
+// startConfiguredRun starts the first run without the menu when its
+// categories are configured, unless a saved game can be resumed from the menu.
+func (h *Hangman) startConfiguredRun() (*HangmanGame, GameState, error) {
+	categories := h.timeAttack.Categories
+	if h.survival.Lives > 0 {
+		categories = h.survival.Categories
+	} else if h.marathon.Enabled {
+		categories = h.marathon.Categories
+	}
+	if !h.runMode() || len(categories) == 0 {
+		return nil, h.gameState, nil
+	}
+	if h.canResume() {
+		logrus.Info("💾 A saved game is waiting, resume it from the menu")
+		return nil, h.gameState, nil
+	}
+
+	words, err := h.categoryWords(categories)
 	if err != nil {
-		return nil, GameStateQuit, fmt.Errorf("prompt failed: %w", err)
+		return nil, GameStateQuit, err
 	}
+	return h.startRun(words, categories)
+}
+
+// setupGame applies the session rules to a new or resumed round.
+func (h *Hangman) setupGame(game *HangmanGame) {
+	game.SetBatchGuesses(h.batchGuesses)
+	game.SetTiming(h.timing)
+	game.SetClock(h.clock)
+}
 
-	if idx == len(items)-1 {
-		return nil, GameStateQuit, nil
+// saveGame writes the round in progress to the save file.
+func (h *Hangman) saveGame(game *HangmanGame) error {
+	if h.saveFile == "" {
+		return errors.New("saving is disabled")
+	}
+	saved := game.Snapshot()
+	if h.marathonRun != nil {
+		saved.Marathon = h.marathonRun.save(h.results)
 	}
+	if err := WriteSavedGame(h.saveFile, saved); err != nil {
+		return err
+	}
+	h.saved = true
+	return nil
+}
 
-	word, err := h.WordLoader.RandomWord(categories[idx])
+// discardSave removes the save file written for the round in progress.
+func (h *Hangman) discardSave() {
+	if !h.saved {
+		return
+	}
+	h.saved = false
+	if err := os.Remove(h.saveFile); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		logrus.WithError(err).Warn("failed to remove the save file")
+	}
+}
+
+// resumeGame restores the saved round and removes the save file.
+func (h *Hangman) resumeGame() (*HangmanGame, error) {
+	saved, err := ReadSavedGame(h.saveFile)
+	if err != nil {
+		return nil, err
+	}
+
+	word, err := h.WordLoader.FindWord(saved.Category, saved.Word)
+	if err != nil {
+		return nil, fmt.Errorf("the saved word is no longer available: %w", err)
+	}
+
+	game, err := RestoreGame(word, *saved, h.scoring)
 	if err != nil {
-		return nil, GameStateQuit, fmt.Errorf("failed to get random word: %w", err)
+		return nil, err
+	}
+	if saved.Marathon != nil {
+		if err := h.resumeMarathon(saved.Marathon); err != nil {
+			return nil, err
+		}
+	}
+	h.setupGame(game)
+	return game, os.Remove(h.saveFile)
+}
+
+// hasSavedGame reports whether there is a saved round to resume.
+func (h *Hangman) hasSavedGame() bool {
+	if h.saveFile == "" {
+		return false
+	}
+	_, err := os.Stat(h.saveFile)
+	return err == nil
+}
+
+// Results returns the results of the rounds played so far, in order.
+func (h *Hangman) Results() []RoundResult {
+	return slices.Clone(h.results)
+}
+
+// menuAction identifies what a category menu entry does when selected.
+type menuAction int
+
+const (
+	menuActionCategory menuAction = iota
+	menuActionGroup
+	menuActionPlayAll
+	menuActionBack
+	menuActionResume
+	menuActionQuit
+)
+
+// menuItem is a single entry of the category menu.
+type menuItem struct {
+	label  string
+	action menuAction
+	target string
+}
+
+// canResume reports whether the menu offers to resume a saved round. Time
+// attack and survival runs cannot be saved, so their menus never do.
+func (h *Hangman) canResume() bool {
+	return h.timeAttack.Duration == 0 && h.survival.Lives == 0 && h.hasSavedGame()
+}
+
+// menuItems builds the category menu entries for a group.
+func (h *Hangman) menuItems(group string) []menuItem {
+	items := make([]menuItem, 0)
+	if group == RootGroup && h.canResume() {
+		items = append(items, menuItem{label: "💾 Resume saved game", action: menuActionResume})
+	}
+	for _, child := range h.WordLoader.SubGroups(group) {
+		items = append(items, menuItem{label: fmt.Sprintf("📁 %s", GroupName(child)), action: menuActionGroup, target: child})
+	}
+	for _, category := range h.WordLoader.CategoriesIn(group) {
+		items = append(items, menuItem{label: fmt.Sprintf("📂 %s", category), action: menuActionCategory, target: category})
+	}
+	if group != RootGroup {
+		items = append(items, menuItem{label: fmt.Sprintf("🎲 Play all in %s", GroupName(group)), action: menuActionPlayAll, target: group})
+		items = append(items, menuItem{label: "⬅️  Back", action: menuActionBack, target: ParentGroup(group)})
+	}
+	items = append(items, menuItem{label: "❌ Quit", action: menuActionQuit})
+	return items
+}
+
+// createGame displays the category menu and creates a new game instance.
+// Groups open a nested menu until a category or "play all" entry is chosen.
+func (h *Hangman) createGame() (*HangmanGame, GameState, error) {
+	group := RootGroup
+	var word *Word
+	for word == nil {
+		items := h.menuItems(group)
+		labels := make([]string, 0, len(items))
+		for _, item := range items {
+			labels = append(labels, item.label)
+		}
+
+		label := "Hangman Menu - Select Category"
+		if group != RootGroup {
+			label = fmt.Sprintf("Hangman Menu - %s", group)
+		}
+
+		prompt := promptui.Select{
+			Label: label,
+			Items: labels,
+			Stdin: newDeadlineReader(stdinPump(), 0),
+		}
+
+		idx, _, err := prompt.Run()
+		if errors.Is(err, promptui.ErrInterrupt) || errors.Is(err, promptui.ErrEOF) {
+			return nil, GameStateQuit, nil
+		}
+		if err != nil {
+			return nil, GameStateQuit, fmt.Errorf("prompt failed: %w", err)
+		}
+
+		item := items[idx]
+		switch item.action {
+		case menuActionQuit:
+			return nil, GameStateQuit, nil
+		case menuActionGroup, menuActionBack:
+			group = item.target
+		case menuActionResume:
+			game, err := h.resumeGame()
+			if err != nil {
+				logrus.WithError(err).Error("failed to resume the saved game")
+				continue
+			}
+			return game, GameStatePlaying, nil
+		case menuActionPlayAll:
+			if h.runMode() {
+				words, err := h.WordLoader.GroupWords(item.target)
+				if err != nil {
+					return nil, GameStateQuit, err
+				}
+				return h.startRun(words, []string{item.target})
+			}
+			word, err = h.WordLoader.RandomGroupWord(item.target)
+		case menuActionCategory:
+			if h.runMode() {
+				words, err := h.WordLoader.GetWords(item.target)
+				if err != nil {
+					return nil, GameStateQuit, err
+				}
+				return h.startRun(words, []string{item.target})
+			}
+			word, err = h.WordLoader.RandomWord(item.target)
+		}
+		if err != nil {
+			return nil, GameStateQuit, fmt.Errorf("failed to get random word: %w", err)
+		}
 	}
+	h.recordPlay(word.Category)
 
 	game, err := NewHangmanGame(word, h.additionalMaxGuesses)
 	if err != nil {
 		return nil, GameStateQuit, fmt.Errorf("failed to create game: %w", err)
 	}
+	game.SetScoring(h.scoring)
+	h.setupGame(game)
 
 	return game, GameStatePlaying, nil
 }
 
+// HintCommand is entered at the guess prompt to reveal the next hint, or
+// followed by a HintKind, e.g. "? vowels", to reveal a structural hint.
+const HintCommand = "?"
+
 type HangmanGame struct {
+	word           *Word
 	hint           string
+	hints          []string
+	nextHintIndex  int
+	hintsUsed      []string
+	structural     map[HintKind]bool
+	powerUpsUsed   map[PowerUp]int
+	eliminated     map[string]bool
+	scoring        Scoring
+	batchGuesses   bool
+	state          GameState
+	started        bool
 	wordIndices    map[string][]int
 	guesses        map[string]bool
 	answer         []string
@@ -125,6 +605,14 @@ type HangmanGame struct {
 	remaining      int
 	score          int
 	streak         int
+	longestStreak  int
+	guessOrder     []string
+	events         []ScoreEvent
+	timing         Timing
+	clock          Clock
+	carried        time.Duration
+	startedAt      time.Time
+	finishedAt     time.Time
 }
 
 // NewHangmanGame creates a new game instance for a specific word.
@@ -139,41 +627,177 @@ func NewHangmanGame(word *Word, maxGuesses int) (*HangmanGame, error) {
 		return nil, errors.New("maxGuesses cannot be negative")
 	}
 
+	// Words without a hint of their own get structural hints instead.
+	hints := word.Hints()
+	if generated := StructuralHints(word); word.Hint == "" && len(generated) > 0 {
+		hints = generated
+	}
+
+	meta := word.Meta()
 	return &HangmanGame{
-		hint:           word.Hint,
-		wordIndices:    word.Indices(),
-		answer:         word.PreAnswer(),
-		alphabetLength: word.AlphabetLength(),
-		remaining:      word.AlphabetLength() + maxGuesses,
+		word:           word,
+		hint:           hints[0],
+		hints:          hints,
+		nextHintIndex:  1,
+		hintsUsed:      []string{hints[0]},
+		structural:     make(map[HintKind]bool),
+		powerUpsUsed:   make(map[PowerUp]int),
+		eliminated:     make(map[string]bool),
+		scoring:        DefaultScoring(),
+		batchGuesses:   true,
+		state:          GameStatePlaying,
+		wordIndices:    meta.Indices,
+		answer:         slices.Clone(meta.Answer),
+		alphabetLength: meta.Length,
+		remaining:      meta.Length + maxGuesses,
 		incorrect:      make([]string, 0),
 		guesses:        make(map[string]bool),
 		score:          0,
 		streak:         0,
+		guessOrder:     make([]string, 0),
+		events:         make([]ScoreEvent, 0),
+		clock:          SystemClock{},
 	}, nil
 }
 
+// SetScoring sets the scoring policy of the round.
+func (g *HangmanGame) SetScoring(scoring Scoring) {
+	g.scoring = scoring
+}
+
+// SetBatchGuesses decides whether several letters can be guessed in one entry.
+func (g *HangmanGame) SetBatchGuesses(enabled bool) {
+	g.batchGuesses = enabled
+}
+
+// Result reports the outcome of the round.
+func (g *HangmanGame) Result() RoundResult {
+	return RoundResult{
+		Word:          *g.word,
+		State:         g.state,
+		Score:         g.score,
+		HintsUsed:     slices.Clone(g.hintsUsed),
+		PowerUpsUsed:  maps.Clone(g.powerUpsUsed),
+		Guesses:       slices.Clone(g.guessOrder),
+		Incorrect:     slices.Clone(g.incorrect),
+		LongestStreak: g.longestStreak,
+		Duration:      g.elapsed(),
+		Events:        slices.Clone(g.events),
+	}
+}
+
 // Play runs the main game loop for a single round.
 func (g *HangmanGame) Play() GameState {
-	logrus.Info("Hint: ", g.hint)
+	g.state = g.play()
+	switch g.state {
+	case GameStateWin, GameStateLose, GameStateAbandon:
+		g.finishedAt = g.clock.Now()
+	}
+	return g.state
+}
+
+// play runs the guess loop and returns the final state.
+// Play may be called again after it returned GameStateSave or GameStateStats
+// to resume the round.
+func (g *HangmanGame) play() GameState {
+	if !g.started {
+		g.started = true
+		g.startClock()
+		g.introduce()
+	}
 	for g.remaining > 0 {
+		if g.timeUp() {
+			logrus.Warn("⏰ Time is up!")
+			return GameStateLose
+		}
+
 		g.displayAnswer()
-		letter, err := g.input()
-		if err != nil {
+		asked := g.clock.Now()
+		guess, err := g.input()
+		switch {
+		case errors.Is(err, errInputExpired):
+			if state, done := g.expire(); done {
+				return state
+			}
+			continue
+		case errors.Is(err, promptui.ErrInterrupt):
+			// Ctrl-C forfeits the round, Ctrl-D below exits the game.
+			return GameStateAbandon
+		case errors.Is(err, promptui.ErrEOF):
+			return GameStateQuit
+		case err != nil:
 			logrus.WithError(err).Error("failed to input the guess letter")
 			return GameStateQuit
 		}
 
-		g.processGuess(letter)
-		if g.isWin() {
-			g.displayAnswer() // Show final answer
-			return GameStateWin
+		if state, done := g.handle(guess, asked); done {
+			return state
 		}
 	}
 
-	g.displayAnswer() // Show final answer on loss
+	// The answer is revealed by the round summary.
 	return GameStateLose
 }
 
+// handle applies an entry asked for at the given time. It reports true with
+// the next state when the entry ends the round or leaves it.
+func (g *HangmanGame) handle(guess string, asked time.Time) (GameState, bool) {
+	command, arg, isCommand, err := parseCommand(guess)
+	switch {
+	case isCommand && err != nil:
+		logrus.Warn(err)
+		return "", false
+	case isCommand && command != CommandHint && command != CommandSolve:
+		// Leaving, saving or asking for help is never too late.
+		return g.runCommand(command, arg)
+	case g.timeUp():
+		logrus.Warn("⏰ Time is up!")
+		return GameStateLose, true
+	case g.guessExpired(asked):
+		return GameStateLose, g.remaining <= 0
+	}
+
+	if isCommand {
+		g.runCommand(command, arg)
+	} else if name, ok := strings.CutPrefix(guess, PowerUpCommand); ok {
+		if err := g.usePowerUp(PowerUp(strings.TrimSpace(name))); err != nil {
+			logrus.Warn(err)
+		}
+	} else if kind, ok := strings.CutPrefix(guess, HintCommand); ok {
+		hint, err := g.requestHint(HintKind(strings.TrimSpace(kind)))
+		if err != nil {
+			logrus.Warn(err)
+		} else {
+			logrus.Infof("Hint %d: %s", len(g.hintsUsed), hint)
+		}
+	} else if g.isSolveAttempt(guess) {
+		if !g.solve(guess) {
+			logrus.Warn("that is not the answer")
+		}
+	} else {
+		g.guessLetters(guess)
+	}
+
+	if g.isWin() {
+		g.addTimeBonus()
+		g.displayAnswer() // Show final answer
+		return GameStateWin, true
+	}
+	return GameStateLose, g.remaining <= 0
+}
+
+// introduce shows the free hint and the help available in the round.
+func (g *HangmanGame) introduce() {
+	logrus.Info("Hint: ", g.hint)
+	if extra := len(g.hints) - 1; extra > 0 {
+		logrus.Infof("%d more hint(s) available, enter %s%s for the next one", extra, CommandPrefix, CommandHint)
+	}
+	if available := g.availablePowerUps(); len(available) > 0 {
+		logrus.Infof("Power-ups: %s", strings.Join(available, ", "))
+	}
+	logrus.Infof("Enter %s%s for the list of commands", CommandPrefix, CommandHelp)
+}
+
 // displayAnswer shows the current game state and statistics.
 func (g *HangmanGame) displayAnswer() {
 	builder := new(strings.Builder)
@@ -181,33 +805,157 @@ func (g *HangmanGame) displayAnswer() {
 	fmt.Fprintf(builder, "\tscore: %d,", g.score)
 	fmt.Fprintf(builder, "\tremaining: %d", g.remaining)
 	fmt.Fprintf(builder, "\tincorrect: %s", strings.Join(g.incorrect, ","))
+	if len(g.eliminated) > 0 {
+		fmt.Fprintf(builder, "\teliminated: %s", strings.Join(slices.Sorted(maps.Keys(g.eliminated)), ","))
+	}
+	if len(g.hintsUsed) > 1 {
+		fmt.Fprintf(builder, "\thints used: %d", len(g.hintsUsed))
+	}
+	if left, timed := g.timeLeft(); timed {
+		fmt.Fprintf(builder, "\t⏱ %s left", left.Round(time.Second))
+	}
+	if g.timing.PerGuess > 0 {
+		fmt.Fprintf(builder, "\t⏳ guess within %s", g.timing.PerGuess)
+	}
 	logrus.Info(builder.String())
 }
 
-// input prompts the user to enter a single alphabetic character.
+// input prompts the user to enter a letter, several letters when batch
+// guesses are enabled, the whole answer, or a command.
 func (g *HangmanGame) input() (string, error) {
 	prompt := promptui.Prompt{
 		Label: ">",
 		Validate: func(s string) error {
-			if len(s) != 1 {
-				return errors.New("you must input single character")
+			if len(strings.TrimSpace(s)) == 0 {
+				return errors.New("you must input a letter or the answer")
 			}
 
-			if !IsAlphabet(s) {
-				return errors.New("you must input alphabetic character")
+			if _, _, ok, err := parseCommand(s); ok {
+				return err
+			}
+
+			if strings.HasPrefix(s, HintCommand) || strings.HasPrefix(s, PowerUpCommand) {
+				return nil
+			}
+
+			if len([]rune(s)) == 1 && !IsAlphabet(s) {
+				return fmt.Errorf("you must input a letter, or %s%s followed by the answer", CommandPrefix, CommandSolve)
 			}
 
 			return nil
 		},
 	}
 
+	// A timed prompt gives up when the guess or the round runs out of time.
+	wait, timed := g.inputDeadline()
+	if timed && wait <= 0 {
+		return "", errInputExpired
+	}
+	stdin := newDeadlineReader(stdinPump(), wait)
+	defer stdin.Close()
+	prompt.Stdin = stdin
+
 	in, err := prompt.Run()
+	if err != nil && stdin.expired.Load() {
+		return "", errInputExpired
+	}
 	if err != nil {
 		return "", fmt.Errorf("prompt failed: %w", err)
 	}
 	return strings.ToLower(in), nil
 }
 
+// requestHint reveals the next hint, or the structural hint of the given
+// kind when kind is not empty.
+func (g *HangmanGame) requestHint(kind HintKind) (string, error) {
+	if kind == "" {
+		return g.nextHint()
+	}
+	return g.structuralHint(kind)
+}
+
+// nextHint reveals the next hint and charges the scoring policy's hint cost.
+func (g *HangmanGame) nextHint() (string, error) {
+	for g.nextHintIndex < len(g.hints) && slices.Contains(g.hintsUsed, g.hints[g.nextHintIndex]) {
+		g.nextHintIndex++
+	}
+	if g.nextHintIndex >= len(g.hints) {
+		return "", fmt.Errorf("no more hints for this word, try %s followed by one of: %s", HintCommand, hintKindList())
+	}
+
+	hint := g.hints[g.nextHintIndex]
+	if err := g.useHint(hint); err != nil {
+		return "", err
+	}
+	g.nextHintIndex++
+	return hint, nil
+}
+
+// structuralHint reveals a hint derived from the word, such as its vowel
+// count, at the same cost as any other extra hint.
+func (g *HangmanGame) structuralHint(kind HintKind) (string, error) {
+	if g.structural[kind] {
+		return "", fmt.Errorf("the %s hint was already shown", kind)
+	}
+
+	hint, ok := StructuralHint(g.word, kind)
+	if !ok {
+		if !slices.Contains(HintKinds, kind) {
+			return "", fmt.Errorf("unknown hint %q, expected one of: %s", kind, hintKindList())
+		}
+		return "", fmt.Errorf("there is no %s hint for this word", kind)
+	}
+	if slices.Contains(g.hintsUsed, hint) {
+		return "", fmt.Errorf("the %s hint was already shown", kind)
+	}
+
+	if err := g.useHint(hint); err != nil {
+		return "", err
+	}
+	g.structural[kind] = true
+	return hint, nil
+}
+
+// useHint charges the scoring policy's hint cost and records the hint.
+func (g *HangmanGame) useHint(hint string) error {
+	if err := g.pay(g.scoring.HintCost, fmt.Sprintf("hint %d", len(g.hintsUsed)+1)); err != nil {
+		return err
+	}
+	g.hintsUsed = append(g.hintsUsed, hint)
+	return nil
+}
+
+// pay deducts a cost from the score and remaining guesses, recording the
+// points actually deducted under reason. Help that would use up the last
+// remaining guess is refused.
+func (g *HangmanGame) pay(cost Cost, reason string) error {
+	if cost.Lives > 0 && cost.Lives >= g.remaining {
+		return fmt.Errorf("this costs %d guess(es) and you only have %d left", cost.Lives, g.remaining)
+	}
+
+	g.remaining -= cost.Lives
+	g.addPoints(-min(cost.Points, g.score), reason)
+	return nil
+}
+
+// addPoints changes the score and records the scoring event.
+func (g *HangmanGame) addPoints(points int, reason string) {
+	if points == 0 {
+		return
+	}
+	g.score += points
+	g.events = append(g.events, ScoreEvent{Reason: reason, Points: points})
+}
+
+// hintKindList joins HintKinds for messages.
+func hintKindList() string {
+	kinds := make([]string, 0, len(HintKinds))
+	for _, kind := range HintKinds {
+		kinds = append(kinds, string(kind))
+	}
+	return strings.Join(kinds, ", ")
+}
+
 // processGuess processes a letter guess and updates the game state.
 func (g *HangmanGame) processGuess(letter string) {
 	if g.guesses[letter] {
@@ -217,25 +965,88 @@ func (g *HangmanGame) processGuess(letter string) {
 		return
 	}
 
-	locs, ok := g.wordIndices[letter]
-	if !ok {
+	if g.eliminated[letter] {
+		logrus.Warnf("%s was eliminated, it is not in the word", letter)
+		return
+	}
+
+	g.guessOrder = append(g.guessOrder, letter)
+	if _, ok := g.wordIndices[letter]; !ok {
 		g.remaining--
 		g.streak = 0
 		g.guesses[letter] = true
 		g.incorrect = append(g.incorrect, letter)
 		return
 	}
+	g.scoreLetter(letter, "letter")
+}
 
-	for _, loc := range locs {
-		g.correctCount++
+// scoreLetter reveals a letter of the word and scores it with the streak.
+func (g *HangmanGame) scoreLetter(letter, reason string) {
+	for _, loc := range g.wordIndices[letter] {
+		if g.answer[loc] == "_" {
+			g.correctCount++
+		}
 		g.answer[loc] = letter
 	}
 
 	g.streak++
-	g.score += PointsPerCorrectGuess * g.streak
+	g.longestStreak = max(g.longestStreak, g.streak)
+	g.addPoints(g.scoring.PointsPerCorrectGuess*g.streak, fmt.Sprintf("%s %s, streak %d", reason, letter, g.streak))
 	g.guesses[letter] = true
 }
 
+// guessLetters guesses each letter of the entry in order, stopping as soon
+// as the round is won or lost. A letter repeated within the entry is guessed
+// once; letters guessed in earlier entries are still charged as repeats.
+func (g *HangmanGame) guessLetters(letters string) {
+	seen := make(map[rune]bool)
+	for _, r := range letters {
+		if seen[r] {
+			continue
+		}
+		seen[r] = true
+		g.processGuess(string(r))
+		if g.isWin() || g.remaining <= 0 {
+			return
+		}
+	}
+}
+
+// isSolveAttempt reports whether a multi-letter entry is an attempt at the
+// whole answer rather than a batch of letters: it is the answer, it is not
+// made of letters only, or batch guesses are disabled.
+func (g *HangmanGame) isSolveAttempt(entry string) bool {
+	if len([]rune(entry)) < 2 {
+		return false
+	}
+	return g.word.Accepts(entry) || !IsAlphabet(entry) || !g.batchGuesses
+}
+
+// solve checks an attempt at the whole answer, which may be the word or any
+// of its aliases. A correct attempt reveals the remaining letters as if they
+// had been guessed in a row; a wrong one costs a guess.
+func (g *HangmanGame) solve(attempt string) bool {
+	if !g.word.Accepts(attempt) {
+		g.remaining--
+		g.streak = 0
+		return false
+	}
+
+	letters := make([]string, 0, len(g.wordIndices))
+	for letter := range g.wordIndices {
+		if IsAlphabet(letter) && !g.guesses[letter] {
+			letters = append(letters, letter)
+		}
+	}
+	slices.Sort(letters)
+
+	for _, letter := range letters {
+		g.scoreLetter(letter, "solved")
+	}
+	return true
+}
+
 // isWin checks if the player has won the game.
 func (g *HangmanGame) isWin() bool {
 	return g.correctCount == g.alphabetLength
@@ -1,7 +1,12 @@
 package hangman
 
 import (
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"testing/fstest"
+	"time"
 )
 
 func TestNewHangmanGame(t *testing.T) {
@@ -330,3 +335,302 @@ func TestHangmanGame_FullGameScenario(t *testing.T) {
 		t.Errorf("Expected fill 3, got %d", game.correctCount)
 	}
 }
+
+func TestHangman_menuItems(t *testing.T) {
+	loader := NewWordLoader()
+	if err := loader.Load("testdata/groups"); err != nil {
+		t.Fatalf("WordLoader.Load() unexpected error = %v", err)
+	}
+	h := &Hangman{WordLoader: loader}
+
+	tests := []struct {
+		name        string
+		group       string
+		wantActions []menuAction
+	}{
+		{
+			name:        "root",
+			group:       RootGroup,
+			wantActions: []menuAction{menuActionGroup, menuActionCategory, menuActionQuit},
+		},
+		{
+			name:        "nested group",
+			group:       "sports",
+			wantActions: []menuAction{menuActionGroup, menuActionGroup, menuActionCategory, menuActionPlayAll, menuActionBack, menuActionQuit},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := h.menuItems(tt.group)
+			actions := make([]menuAction, 0, len(items))
+			for _, item := range items {
+				actions = append(actions, item.action)
+			}
+			if !reflect.DeepEqual(actions, tt.wantActions) {
+				t.Errorf("Hangman.menuItems() actions = %v, want %v", actions, tt.wantActions)
+			}
+		})
+	}
+}
+
+func TestNewHangman_FallbackFS(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.DataDir = "testdata/nonexistent"
+
+	if _, err := NewHangman(cfg); err == nil {
+		t.Error("NewHangman() expected error without data directory or fallback")
+	}
+
+	cfg.FallbackFS = fstest.MapFS{
+		"animals.txt": {Data: []byte("Animals\nCat,Kitty\n")},
+	}
+	h, err := NewHangman(cfg)
+	if err != nil {
+		t.Fatalf("NewHangman() unexpected error = %v", err)
+	}
+	if !reflect.DeepEqual(h.WordLoader.Categories(), []string{"Animals"}) {
+		t.Errorf("NewHangman() categories = %v, want [Animals]", h.WordLoader.Categories())
+	}
+}
+
+func TestNewHangman_PlaysFile(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.DataDir = "testdata/ordered"
+	cfg.CategoryOrder = OrderMostPlayed
+	cfg.PlaysFile = filepath.Join(t.TempDir(), "plays.json")
+
+	h, err := NewHangman(cfg)
+	if err != nil {
+		t.Fatalf("NewHangman() unexpected error = %v", err)
+	}
+	h.recordPlay("Middle")
+	h.recordPlay("Middle")
+	h.recordPlay("apple things")
+
+	// A new session orders categories by the plays of the previous one.
+	h, err = NewHangman(cfg)
+	if err != nil {
+		t.Fatalf("NewHangman() unexpected error = %v", err)
+	}
+	want := []string{"Middle", "apple things", "Zebra Things"}
+	if got := h.WordLoader.Categories(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Categories() = %v, want %v", got, want)
+	}
+}
+
+func TestHangmanGame_solve(t *testing.T) {
+	word := &Word{Text: "Tottenham Hotspur", Aliases: []string{"Spurs"}, Hint: "Lilywhites"}
+
+	tests := []struct {
+		name          string
+		attempt       string
+		want          bool
+		wantWin       bool
+		wantRemaining int
+	}{
+		{"canonical", "tottenham hotspur", true, true, 22},
+		{"alias", "SPURS", true, true, 22},
+		{"ignores punctuation", "Tottenham-Hotspur", true, true, 22},
+		{"wrong", "Arsenal", false, false, 21},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game, err := NewHangmanGame(word, 6)
+			if err != nil {
+				t.Fatalf("NewHangmanGame() unexpected error = %v", err)
+			}
+			game.processGuess("t")
+
+			if got := game.solve(tt.attempt); got != tt.want {
+				t.Errorf("solve(%q) = %v, want %v", tt.attempt, got, tt.want)
+			}
+			if got := game.isWin(); got != tt.wantWin {
+				t.Errorf("isWin() = %v, want %v", got, tt.wantWin)
+			}
+			if game.remaining != tt.wantRemaining {
+				t.Errorf("remaining = %d, want %d", game.remaining, tt.wantRemaining)
+			}
+			if tt.want && strings.Join(game.answer, "") != "tottenham hotspur" {
+				t.Errorf("answer = %q, want the canonical word revealed", strings.Join(game.answer, ""))
+			}
+		})
+	}
+}
+
+func TestHangmanGame_nextHint(t *testing.T) {
+	word := &Word{Text: "Owl", Hint: "A bird", ExtraHints: []string{"Hoots at night", "Wise"}}
+
+	tests := []struct {
+		name          string
+		cost          Cost
+		requests      int
+		wantErr       bool
+		wantScore     int
+		wantRemaining int
+		wantUsed      []string
+	}{
+		{"one hint costs points", Cost{Points: 5}, 1, false, 5, 6, []string{"A bird", "Hoots at night"}},
+		{"score never negative", Cost{Points: 50}, 1, false, 0, 6, []string{"A bird", "Hoots at night"}},
+		{"hint costs lives", Cost{Lives: 2}, 2, false, 10, 2, []string{"A bird", "Hoots at night", "Wise"}},
+		{"no more hints", Cost{}, 3, true, 10, 6, []string{"A bird", "Hoots at night", "Wise"}},
+		{"last life refused", Cost{Lives: 6}, 1, true, 10, 6, []string{"A bird"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game, err := NewHangmanGame(word, 3)
+			if err != nil {
+				t.Fatalf("NewHangmanGame() unexpected error = %v", err)
+			}
+			game.SetScoring(Scoring{PointsPerCorrectGuess: 10, HintCost: tt.cost})
+			game.processGuess("o")
+
+			for i := range tt.requests {
+				_, err = game.nextHint()
+				if err != nil && i < tt.requests-1 {
+					t.Fatalf("nextHint() unexpected error = %v", err)
+				}
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("nextHint() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if game.score != tt.wantScore {
+				t.Errorf("score = %d, want %d", game.score, tt.wantScore)
+			}
+			if game.remaining != tt.wantRemaining {
+				t.Errorf("remaining = %d, want %d", game.remaining, tt.wantRemaining)
+			}
+			if got := game.Result().HintsUsed; !reflect.DeepEqual(got, tt.wantUsed) {
+				t.Errorf("Result().HintsUsed = %v, want %v", got, tt.wantUsed)
+			}
+		})
+	}
+}
+
+func TestHangmanGame_Result(t *testing.T) {
+	game, err := NewHangmanGame(&Word{Text: "Owl", Hint: "A bird", ExtraHints: []string{"Hoots at night"}}, 3)
+	if err != nil {
+		t.Fatalf("NewHangmanGame() unexpected error = %v", err)
+	}
+	game.SetScoring(Scoring{PointsPerCorrectGuess: 10, HintCost: Cost{Points: 50}})
+	game.processGuess("o")
+	game.processGuess("w")
+	game.processGuess("x")
+	if _, err := game.nextHint(); err != nil {
+		t.Fatalf("nextHint() unexpected error = %v", err)
+	}
+	game.processGuess("o")
+	game.solve("owl")
+
+	result := game.Result()
+	if want := []string{"o", "w", "x"}; !reflect.DeepEqual(result.Guesses, want) {
+		t.Errorf("Guesses = %v, want %v", result.Guesses, want)
+	}
+	if want := []string{"x"}; !reflect.DeepEqual(result.Incorrect, want) {
+		t.Errorf("Incorrect = %v, want %v", result.Incorrect, want)
+	}
+	if result.LongestStreak != 2 {
+		t.Errorf("LongestStreak = %d, want 2", result.LongestStreak)
+	}
+	wantEvents := []ScoreEvent{
+		{"letter o, streak 1", 10},
+		{"letter w, streak 2", 20},
+		{"hint 2", -30},
+		{"solved l, streak 1", 10},
+	}
+	if !reflect.DeepEqual(result.Events, wantEvents) {
+		t.Errorf("Events = %v, want %v", result.Events, wantEvents)
+	}
+
+	total := 0
+	for _, event := range result.Events {
+		total += event.Points
+	}
+	if total != result.Score {
+		t.Errorf("events add up to %d, want the score %d", total, result.Score)
+	}
+
+	summary := result.Summary()
+	for _, want := range []string{"Answer: Owl", "Hints: A bird; Hoots at night", "Guessed: o, w, x", "Wrong: x", "Longest streak: 2", "-30  hint 2", "10  total"} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("Summary() = %q, want it to contain %q", summary, want)
+		}
+	}
+}
+
+func TestHangmanGame_guessLetters(t *testing.T) {
+	tests := []struct {
+		name          string
+		letters       string
+		maxGuesses    int
+		wantAnswer    string
+		wantGuesses   []string
+		wantRemaining int
+	}{
+		{"in order", "oz", 3, "o _ _", []string{"o", "z"}, 5},
+		{"stops on win", "owlxyz", 3, "o w l", []string{"o", "w", "l"}, 6},
+		{"stops on loss", "xyzwq", 0, "_ _ _", []string{"x", "y", "z"}, 0},
+		{"repeats in the entry are guessed once", "oxo", 3, "o _ _", []string{"o", "x"}, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game, err := NewHangmanGame(&Word{Text: "Owl", Hint: "A bird"}, tt.maxGuesses)
+			if err != nil {
+				t.Fatalf("NewHangmanGame() unexpected error = %v", err)
+			}
+			game.guessLetters(tt.letters)
+
+			if got := strings.Join(game.answer, " "); got != tt.wantAnswer {
+				t.Errorf("answer = %q, want %q", got, tt.wantAnswer)
+			}
+			if !reflect.DeepEqual(game.guessOrder, tt.wantGuesses) {
+				t.Errorf("guesses = %v, want %v", game.guessOrder, tt.wantGuesses)
+			}
+			if game.remaining != tt.wantRemaining {
+				t.Errorf("remaining = %d, want %d", game.remaining, tt.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestHangmanGame_handle_entries(t *testing.T) {
+	tests := []struct {
+		name          string
+		entry         string
+		batch         bool
+		wantState     GameState
+		wantAnswer    string
+		wantRemaining int
+	}{
+		{"correct word solves", "owl", true, GameStateWin, "o w l", 6},
+		{"alias solves", "owlet", true, GameStateWin, "o w l", 6},
+		{"wrong word is guessed as letters", "cow", true, GameStateLose, "o w _", 5},
+		{"wrong answer with a space costs one guess", "big owl", true, GameStateLose, "_ _ _", 5},
+		{"correct word without batch guesses", "owl", false, GameStateWin, "o w l", 6},
+		{"wrong word without batch guesses costs one guess", "cow", false, GameStateLose, "_ _ _", 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game, err := NewHangmanGame(&Word{Text: "Owl", Aliases: []string{"Owlet"}, Hint: "A bird"}, 3)
+			if err != nil {
+				t.Fatalf("NewHangmanGame() unexpected error = %v", err)
+			}
+			game.SetBatchGuesses(tt.batch)
+
+			state, done := game.handle(tt.entry, time.Now())
+			if state != tt.wantState || done != (tt.wantState == GameStateWin) {
+				t.Errorf("handle() = %q, %v, want %q", state, done, tt.wantState)
+			}
+			if got := strings.Join(game.answer, " "); got != tt.wantAnswer {
+				t.Errorf("answer = %q, want %q", got, tt.wantAnswer)
+			}
+			if game.remaining != tt.wantRemaining {
+				t.Errorf("remaining = %d, want %d", game.remaining, tt.wantRemaining)
+			}
+		})
+	}
+}
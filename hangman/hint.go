@@ -0,0 +1,91 @@
+package hangman
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// HintKind names a structural hint derived from the word itself.
+type HintKind string
+
+const (
+	HintLetters  = HintKind("letters")
+	HintWords    = HintKind("words")
+	HintVowels   = HintKind("vowels")
+	HintFirst    = HintKind("first")
+	HintLast     = HintKind("last")
+	HintCategory = HintKind("category")
+)
+
+// HintKinds lists the structural hints in the order they are offered, the
+// least revealing first.
+var HintKinds = []HintKind{HintLetters, HintWords, HintCategory, HintVowels, HintFirst, HintLast}
+
+// StructuralHint derives a hint of the given kind from the word. It reports
+// false when the kind does not apply, e.g. a word count for a single word.
+func StructuralHint(word *Word, kind HintKind) (string, bool) {
+	words := strings.FieldsFunc(word.Text, func(r rune) bool { return !unicode.IsLetter(r) })
+	if len(words) == 0 {
+		return "", false
+	}
+
+	switch kind {
+	case HintCategory:
+		if word.Category == "" {
+			return "", false
+		}
+		return fmt.Sprintf("It is in the category %q", word.Category), true
+	case HintLetters:
+		return fmt.Sprintf("It has %s", plural(word.Meta().Length, "letter")), true
+	case HintWords:
+		if len(words) < 2 {
+			return "", false
+		}
+		return fmt.Sprintf("It is made of %d words", len(words)), true
+	case HintVowels:
+		vowels := 0
+		for _, r := range strings.ToLower(word.Text) {
+			if strings.ContainsRune("aeiou", r) {
+				vowels++
+			}
+		}
+		return fmt.Sprintf("It has %s", plural(vowels, "vowel")), true
+	case HintFirst:
+		first := []rune(words[0])[0]
+		return fmt.Sprintf("It starts with %q", unicode.ToUpper(first)), true
+	case HintLast:
+		last := []rune(words[len(words)-1])
+		return fmt.Sprintf("It ends with %q", unicode.ToUpper(last[len(last)-1])), true
+	default:
+		return "", false
+	}
+}
+
+// StructuralHints returns every structural hint that applies to the word, in
+// the order of HintKinds.
+func StructuralHints(word *Word) []string {
+	hints := []string{}
+	for _, kind := range HintKinds {
+		if hint, ok := StructuralHint(word, kind); ok {
+			hints = append(hints, hint)
+		}
+	}
+	return hints
+}
+
+// plural formats a count with a noun, adding "s" unless the count is one.
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// lives formats a number of lives, which plural cannot spell.
+func lives(n int) string {
+	if n == 1 {
+		return "1 life"
+	}
+	return fmt.Sprintf("%d lives", n)
+}
@@ -0,0 +1,83 @@
+package hangman
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStructuralHint(t *testing.T) {
+	phrase := &Word{Text: "Ice Cream", Category: "Desserts"}
+	single := &Word{Text: "Owl"}
+
+	tests := []struct {
+		name   string
+		word   *Word
+		kind   HintKind
+		want   string
+		wantOK bool
+	}{
+		{"letters", phrase, HintLetters, "It has 8 letters", true},
+		{"words", phrase, HintWords, "It is made of 2 words", true},
+		{"single word has no word count", single, HintWords, "", false},
+		{"vowels", phrase, HintVowels, "It has 4 vowels", true},
+		{"first letter", phrase, HintFirst, "It starts with 'I'", true},
+		{"last letter", phrase, HintLast, "It ends with 'M'", true},
+		{"category", phrase, HintCategory, `It is in the category "Desserts"`, true},
+		{"no category", single, HintCategory, "", false},
+		{"unknown kind", single, HintKind("colour"), "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := StructuralHint(tt.word, tt.kind)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("StructuralHint(%q, %s) = %q, %v, want %q, %v", tt.word.Text, tt.kind, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestHangmanGame_structuralHints(t *testing.T) {
+	game, err := NewHangmanGame(&Word{Text: "Owl", Category: "Birds"}, 3)
+	if err != nil {
+		t.Fatalf("NewHangmanGame() unexpected error = %v", err)
+	}
+	if game.hint != "It has 3 letters" {
+		t.Errorf("hint = %q, want the letter count for a word without a hint", game.hint)
+	}
+
+	if _, err := game.requestHint(HintVowels); err != nil {
+		t.Fatalf("requestHint(vowels) unexpected error = %v", err)
+	}
+	if _, err := game.requestHint(HintVowels); err == nil {
+		t.Error("requestHint(vowels) twice, want error")
+	}
+	if _, err := game.requestHint(HintWords); err == nil {
+		t.Error("requestHint(words) for a single word, want error")
+	}
+	if _, err := game.requestHint(""); err != nil {
+		t.Fatalf("requestHint() unexpected error = %v", err)
+	}
+
+	want := []string{"It has 3 letters", "It has 1 vowel", `It is in the category "Birds"`}
+	if got := game.Result().HintsUsed; !reflect.DeepEqual(got, want) {
+		t.Errorf("Result().HintsUsed = %v, want %v", got, want)
+	}
+
+	// The sequence skips hints that were already requested by kind.
+	game.requestHint(HintFirst)
+	if _, err := game.requestHint(""); err != nil {
+		t.Fatalf("requestHint() unexpected error = %v", err)
+	}
+	if got := game.Result().HintsUsed[4]; got != "It ends with 'L'" {
+		t.Errorf("HintsUsed[4] = %q, want the last letter", got)
+	}
+}
+
+func TestLives(t *testing.T) {
+	for n, want := range map[int]string{0: "0 lives", 1: "1 life", 3: "3 lives"} {
+		if got := lives(n); got != want {
+			t.Errorf("lives(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package hangman
+
+import (
+	"slices"
+)
+
+// wordRef points at a word by category position and index within the category.
+type wordRef struct {
+	category int32
+	index    int32
+}
+
+// indexWords adds the words appended to category from position start onwards
+// to the secondary indexes, filling in any missing metadata.
+func (l *WordLoader) indexWords(category string, start int) {
+	pos, ok := l.categoryIndex[category]
+	if !ok {
+		pos = int32(len(l.categoryIndex))
+		l.categoryIndex[category] = pos
+	}
+
+	words := l.categoryWords[category]
+	for i := start; i < len(words); i++ {
+		word := &words[i]
+		if !word.meta.computed {
+			word.meta = computeMeta(word.Text)
+		}
+
+		ref := wordRef{category: pos, index: int32(i)}
+		l.byLength[word.meta.Length] = append(l.byLength[word.meta.Length], ref)
+		for _, tag := range word.Tags {
+			l.byTag[tag] = append(l.byTag[tag], ref)
+		}
+	}
+}
+
+// resolve returns copies of the referenced words.
+func (l *WordLoader) resolve(refs []wordRef) []Word {
+	words := make([]Word, 0, len(refs))
+	for _, ref := range refs {
+		words = append(words, l.categoryWords[l.categories[ref.category]][ref.index])
+	}
+	return words
+}
+
+// WordsByLength returns every word with between minLength and maxLength
+// letters to guess, inclusive. A maxLength of zero means no upper bound.
+func (l *WordLoader) WordsByLength(minLength, maxLength int) []Word {
+	lengths := make([]int, 0, len(l.byLength))
+	for length := range l.byLength {
+		if length >= minLength && (maxLength == 0 || length <= maxLength) {
+			lengths = append(lengths, length)
+		}
+	}
+	slices.Sort(lengths)
+
+	refs := []wordRef{}
+	for _, length := range lengths {
+		refs = append(refs, l.byLength[length]...)
+	}
+	return l.resolve(refs)
+}
+
+// WordsByTag returns every word carrying the tag.
+func (l *WordLoader) WordsByTag(tag string) []Word {
+	return l.resolve(l.byTag[tag])
+}
+
+// Tags returns every tag used by a loaded word, sorted.
+func (l *WordLoader) Tags() []string {
+	tags := make([]string, 0, len(l.byTag))
+	for tag := range l.byTag {
+		tags = append(tags, tag)
+	}
+	slices.Sort(tags)
+	return tags
+}
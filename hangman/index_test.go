@@ -0,0 +1,181 @@
+package hangman
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func testIndexLoader(t *testing.T) *WordLoader {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"animals.txt": "Animals\nCat,Kitty,[pet short]\nElephant,Big ears,[wild]\nDog,Puppy,[pet short]\n",
+		"fruits.txt":  "Fruits\nApple,Red,[short]\nKiwi,Fuzzy\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	loader := NewWordLoader()
+	if err := loader.Load(dir); err != nil {
+		t.Fatalf("Load() unexpected error = %v", err)
+	}
+	return loader
+}
+
+func texts(words []Word) []string {
+	result := []string{}
+	for _, word := range words {
+		result = append(result, word.Text)
+	}
+	return result
+}
+
+func TestWordLoader_WordsByLength(t *testing.T) {
+	loader := testIndexLoader(t)
+
+	tests := []struct {
+		name     string
+		min, max int
+		want     []string
+	}{
+		{"exact", 3, 3, []string{"Cat", "Dog"}},
+		{"range", 4, 5, []string{"Kiwi", "Apple"}},
+		{"no upper bound", 6, 0, []string{"Elephant"}},
+		{"none", 20, 30, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := texts(loader.WordsByLength(tt.min, tt.max)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WordsByLength(%d, %d) = %v, want %v", tt.min, tt.max, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordLoader_WordsByTag(t *testing.T) {
+	loader := testIndexLoader(t)
+
+	tests := []struct {
+		tag  string
+		want []string
+	}{
+		{"pet", []string{"Cat", "Dog"}},
+		{"short", []string{"Cat", "Dog", "Apple"}},
+		{"wild", []string{"Elephant"}},
+		{"missing", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			if got := texts(loader.WordsByTag(tt.tag)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WordsByTag(%q) = %v, want %v", tt.tag, got, tt.want)
+			}
+		})
+	}
+
+	if got, want := loader.Tags(), []string{"pet", "short", "wild"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Tags() = %v, want %v", got, want)
+	}
+}
+
+func TestWordLoader_IndexesSources(t *testing.T) {
+	loader := NewWordLoader()
+	if err := loader.LoadSource(testMemorySource()); err != nil {
+		t.Fatalf("LoadSource() unexpected error = %v", err)
+	}
+
+	if got, want := texts(loader.WordsByLength(3, 3)), []string{"Cat", "Dog"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("WordsByLength(3, 3) = %v, want %v", got, want)
+	}
+
+	words, _ := loader.GetWords("Fruits")
+	if words[0].meta.Length != 5 {
+		t.Errorf("meta.Length = %d, want 5", words[0].meta.Length)
+	}
+}
+
+// writeCorpus writes files word files of perFile generated words each.
+func writeCorpus(b *testing.B, files, perFile int) string {
+	b.Helper()
+	dir := b.TempDir()
+	for f := range files {
+		var content strings.Builder
+		fmt.Fprintf(&content, "Category %d\n", f)
+		for i := range perFile {
+			fmt.Fprintf(&content, "word%s,hint number %d,[tag%d]\n", letters(f*perFile+i), i, i%10)
+		}
+		if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("words%03d.txt", f)), []byte(content.String()), 0o644); err != nil {
+			b.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// letters spells n in base 26 with the letters a-z.
+func letters(n int) string {
+	var s []byte
+	for {
+		s = append(s, byte('a'+n%26))
+		n /= 26
+		if n == 0 {
+			return string(s)
+		}
+	}
+}
+
+// BenchmarkWordLoader_Load loads a corpus of one million words and reports
+// the heap retained by the loader.
+func BenchmarkWordLoader_Load(b *testing.B) {
+	dir := writeCorpus(b, 100, 10_000)
+	b.ReportAllocs()
+
+	var loader *WordLoader
+	for b.Loop() {
+		loader = NewWordLoader()
+		if err := loader.Load(dir); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.StopTimer()
+	runtime.GC()
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	b.ReportMetric(float64(stats.HeapAlloc)/(1<<20), "heap-MB")
+	runtime.KeepAlive(loader)
+}
+
+func BenchmarkWordLoader_WordsByTag(b *testing.B) {
+	dir := writeCorpus(b, 100, 10_000)
+	loader := NewWordLoader()
+	if err := loader.Load(dir); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		loader.WordsByTag("tag3")
+	}
+}
+
+func BenchmarkWordLoader_WordsByLength(b *testing.B) {
+	dir := writeCorpus(b, 100, 10_000)
+	loader := NewWordLoader()
+	if err := loader.Load(dir); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		loader.WordsByLength(8, 8)
+	}
+}
@@ -0,0 +1,128 @@
+package hangman
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// LeaderboardSize is how many entries every mode keeps.
+const LeaderboardSize = 10
+
+// Mode is a way of playing, ranked on its own leaderboard.
+type Mode string
+
+const (
+	// ModeClassic ranks single rounds chosen from the menu.
+	ModeClassic = Mode("classic")
+	// ModeTimeAttack ranks time-attack runs.
+	ModeTimeAttack = Mode("time-attack")
+	// ModeSurvival ranks survival runs.
+	ModeSurvival = Mode("survival")
+	// ModeMarathon ranks marathons.
+	ModeMarathon = Mode("marathon")
+)
+
+// LeaderboardEntry is a ranked game.
+type LeaderboardEntry struct {
+	Score int `json:"score"`
+	// Solved counts the words solved, and Rounds the words played.
+	Solved int `json:"solved"`
+	Rounds int `json:"rounds"`
+	// Average is the average time spent on a solved word.
+	Average    time.Duration `json:"average"`
+	Categories []string      `json:"categories"`
+	PlayedAt   time.Time     `json:"played_at"`
+}
+
+// String formats the entry on one line.
+func (e LeaderboardEntry) String() string {
+	return fmt.Sprintf("%d points, %d/%d solved, %s per word, %s (%s)",
+		e.Score, e.Solved, e.Rounds, e.Average.Round(time.Second),
+		strings.Join(e.Categories, ", "), e.PlayedAt.Format(time.DateOnly))
+}
+
+// Leaderboard keeps the best games of every mode, best first.
+type Leaderboard struct {
+	Modes map[Mode][]LeaderboardEntry `json:"modes"`
+}
+
+// DefaultLeaderboardFile returns the per-user leaderboard, e.g.
+// ~/.config/hangman/leaderboard.json.
+func DefaultLeaderboardFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "hangman", "leaderboard.json"), nil
+}
+
+// Add ranks an entry among the games of a mode and returns its 1-based
+// rank, or 0 when it did not make the board.
+func (b *Leaderboard) Add(mode Mode, entry LeaderboardEntry) int {
+	if b.Modes == nil {
+		b.Modes = make(map[Mode][]LeaderboardEntry)
+	}
+
+	entries := b.Modes[mode]
+	// Ties go to the earlier game.
+	rank, _ := slices.BinarySearchFunc(entries, entry, func(a, b LeaderboardEntry) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.Solved, a.Solved); c != 0 {
+			return c
+		}
+		return -1
+	})
+	if rank >= LeaderboardSize {
+		return 0
+	}
+
+	entries = slices.Insert(entries, rank, entry)
+	b.Modes[mode] = entries[:min(len(entries), LeaderboardSize)]
+	return rank + 1
+}
+
+// Top returns the entries of a mode, best first.
+func (b *Leaderboard) Top(mode Mode) []LeaderboardEntry {
+	return slices.Clone(b.Modes[mode])
+}
+
+// ReadLeaderboard reads the leaderboard at path; a missing file is an empty
+// leaderboard.
+func ReadLeaderboard(path string) (*Leaderboard, error) {
+	board := &Leaderboard{Modes: make(map[Mode][]LeaderboardEntry)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return board, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, board); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return board, nil
+}
+
+// WriteLeaderboard writes the leaderboard to path, creating its directory.
+func WriteLeaderboard(path string, board *Leaderboard) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(board, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, append(data, '\n'), 0o644)
+}
@@ -0,0 +1,82 @@
+package hangman
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLeaderboard_Add(t *testing.T) {
+	board := &Leaderboard{}
+	for _, score := range []int{50, 30, 10} {
+		board.Add(ModeTimeAttack, LeaderboardEntry{Score: score, Solved: score / 10})
+	}
+
+	tests := []struct {
+		name     string
+		mode     Mode
+		entry    LeaderboardEntry
+		wantRank int
+	}{
+		{"best", ModeTimeAttack, LeaderboardEntry{Score: 60}, 1},
+		{"between", ModeTimeAttack, LeaderboardEntry{Score: 40}, 2},
+		{"ties go to the earlier game", ModeTimeAttack, LeaderboardEntry{Score: 30, Solved: 3}, 3},
+		{"more words solved break ties", ModeTimeAttack, LeaderboardEntry{Score: 30, Solved: 4}, 2},
+		{"last", ModeTimeAttack, LeaderboardEntry{Score: 0}, 4},
+		{"modes are separate", ModeClassic, LeaderboardEntry{Score: 0}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board := &Leaderboard{Modes: map[Mode][]LeaderboardEntry{ModeTimeAttack: board.Top(ModeTimeAttack)}}
+			if got := board.Add(tt.mode, tt.entry); got != tt.wantRank {
+				t.Errorf("Add() = %d, want %d", got, tt.wantRank)
+			}
+			if got := board.Top(tt.mode)[tt.wantRank-1]; !reflect.DeepEqual(got, tt.entry) {
+				t.Errorf("Top()[%d] = %+v, want %+v", tt.wantRank-1, got, tt.entry)
+			}
+		})
+	}
+}
+
+func TestLeaderboard_Add_full(t *testing.T) {
+	board := &Leaderboard{}
+	for score := range LeaderboardSize {
+		board.Add(ModeClassic, LeaderboardEntry{Score: 100 + score})
+	}
+
+	if got := board.Add(ModeClassic, LeaderboardEntry{Score: 1}); got != 0 {
+		t.Errorf("Add() of a score below a full board = %d, want 0", got)
+	}
+	if got := board.Add(ModeClassic, LeaderboardEntry{Score: 200}); got != 1 {
+		t.Errorf("Add() of the best score = %d, want 1", got)
+	}
+	top := board.Top(ModeClassic)
+	if len(top) != LeaderboardSize || top[len(top)-1].Score != 101 {
+		t.Errorf("Top() = %v, want %d entries with the lowest dropped", top, LeaderboardSize)
+	}
+}
+
+func TestReadLeaderboard(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "leaderboard.json")
+	board, err := ReadLeaderboard(path)
+	if err != nil {
+		t.Fatalf("ReadLeaderboard() of a missing file unexpected error = %v", err)
+	}
+	if len(board.Top(ModeClassic)) != 0 {
+		t.Errorf("ReadLeaderboard() of a missing file = %v, want an empty board", board)
+	}
+
+	board.Add(ModeClassic, LeaderboardEntry{Score: 10, Solved: 1, Rounds: 1, Categories: []string{"Animals"}})
+	board.Add(ModeTimeAttack, LeaderboardEntry{Score: 90, Solved: 3, Rounds: 4, Categories: []string{"Animals"}})
+	if err := WriteLeaderboard(path, board); err != nil {
+		t.Fatalf("WriteLeaderboard() unexpected error = %v", err)
+	}
+	got, err := ReadLeaderboard(path)
+	if err != nil {
+		t.Fatalf("ReadLeaderboard() unexpected error = %v", err)
+	}
+	if !reflect.DeepEqual(got, board) {
+		t.Errorf("ReadLeaderboard() = %+v, want %+v", got, board)
+	}
+}
@@ -0,0 +1,135 @@
+package hangman
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Marathon plays every word of a category once, in random order.
+type Marathon struct {
+	// Enabled replaces classic rounds with marathons.
+	Enabled bool
+	// Categories are the categories played through; when empty the first
+	// marathon asks for a category or group in the menu.
+	Categories []string
+}
+
+// SavedMarathon is a marathon paused with :save, stored with its round in
+// progress.
+type SavedMarathon struct {
+	Categories []string    `json:"categories"`
+	Words      []SavedWord `json:"words"`
+	// Next is the index of the word after the round in progress.
+	Next int `json:"next"`
+	// Results are the rounds already finished.
+	Results []RoundResult `json:"results"`
+}
+
+// SavedWord identifies a word of a saved marathon.
+type SavedWord struct {
+	Category string `json:"category"`
+	Text     string `json:"text"`
+}
+
+// marathonRun is a marathon in progress.
+type marathonRun struct {
+	words      []Word
+	next       int
+	categories []string
+	// first is the index of the marathon's first round in Hangman.results.
+	first int
+}
+
+// startMarathon starts a marathon over words, shuffled.
+func (h *Hangman) startMarathon(words []Word, categories []string) {
+	words = slices.Clone(words)
+	rand.Shuffle(len(words), func(i, j int) { words[i], words[j] = words[j], words[i] })
+	h.marathonRun = &marathonRun{words: words, categories: categories, first: len(h.results)}
+	logrus.Infof("🏃 Marathon: %s to play", plural(len(words), "word"))
+}
+
+// nextMarathonRound starts the next word of the marathon, or ends the
+// marathon once every word was played.
+func (h *Hangman) nextMarathonRound() (*HangmanGame, GameState, error) {
+	run := h.marathonRun
+	if run.next >= len(run.words) {
+		h.finishMarathon(runOver)
+		return nil, GameStatePending, nil
+	}
+
+	word := &run.words[run.next]
+	run.next++
+	h.recordPlay(word.Category)
+
+	game, err := NewHangmanGame(word, h.additionalMaxGuesses)
+	if err != nil {
+		return nil, GameStateQuit, fmt.Errorf("failed to create game: %w", err)
+	}
+	game.SetScoring(h.scoring)
+	h.setupGame(game)
+
+	logrus.Infof("🏃 Word %s, score so far: %d", run.progress(), SummarizeRun(h.results[run.first:]).Score)
+	return game, GameStatePlaying, nil
+}
+
+// progress describes the word in progress, e.g. "7/20".
+func (r *marathonRun) progress() string {
+	return fmt.Sprintf("%d/%d", r.next, len(r.words))
+}
+
+// finishMarathon shows the result of the marathon and ranks it.
+func (h *Hangman) finishMarathon(end runEnd) {
+	run := h.marathonRun
+	result := SummarizeRun(h.results[run.first:])
+	if end == runStopped {
+		logrus.Info("🏁 Marathon ended! ", result)
+	} else {
+		logrus.Info("🏁 Marathon finished! ", result)
+	}
+	h.recordScore(ModeMarathon, LeaderboardEntry{
+		Score:      result.Score,
+		Solved:     result.Solved,
+		Rounds:     result.Rounds,
+		Average:    result.Average,
+		Categories: run.categories,
+		PlayedAt:   h.clock.Now(),
+	})
+	h.marathonRun = nil
+}
+
+// save captures the marathon for a save file.
+func (r *marathonRun) save(results []RoundResult) *SavedMarathon {
+	words := make([]SavedWord, 0, len(r.words))
+	for _, word := range r.words {
+		words = append(words, SavedWord{Category: word.Category, Text: word.Text})
+	}
+	return &SavedMarathon{
+		Categories: slices.Clone(r.categories),
+		Words:      words,
+		Next:       r.next,
+		Results:    slices.Clone(results[r.first:]),
+	}
+}
+
+// resumeMarathon restores a saved marathon and its finished rounds.
+func (h *Hangman) resumeMarathon(saved *SavedMarathon) error {
+	words := make([]Word, 0, len(saved.Words))
+	for _, ref := range saved.Words {
+		word, err := h.WordLoader.FindWord(ref.Category, ref.Text)
+		if err != nil {
+			return fmt.Errorf("the saved marathon is no longer available: %w", err)
+		}
+		words = append(words, *word)
+	}
+	if saved.Next < 1 || saved.Next > len(words) {
+		return fmt.Errorf("the saved marathon is at word %d of %d", saved.Next, len(words))
+	}
+
+	h.marathonRun = &marathonRun{words: words, next: saved.Next, categories: saved.Categories, first: len(h.results)}
+	h.results = append(h.results, saved.Results...)
+	logrus.Infof("🏃 Marathon resumed at word %s", h.marathonRun.progress())
+	return nil
+}
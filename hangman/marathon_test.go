@@ -0,0 +1,322 @@
+package hangman
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func newMarathonHangman(t *testing.T, dir string) *Hangman {
+	t.Helper()
+	loader := NewWordLoader()
+	if err := loader.Load("testdata/groups"); err != nil {
+		t.Fatalf("WordLoader.Load() unexpected error = %v", err)
+	}
+	return &Hangman{
+		WordLoader:      loader,
+		scoring:         DefaultScoring(),
+		clock:           &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		marathon:        Marathon{Enabled: true},
+		saveFile:        filepath.Join(dir, "save.json"),
+		leaderboardFile: filepath.Join(dir, "leaderboard.json"),
+	}
+}
+
+// playMarathonRound wins the next round of the marathon.
+func playMarathonRound(t *testing.T, h *Hangman) *HangmanGame {
+	t.Helper()
+	game, state, err := h.nextMarathonRound()
+	if err != nil || state != GameStatePlaying {
+		t.Fatalf("nextMarathonRound() = %v, %q, want a round", err, state)
+	}
+	game.solve(game.word.Text)
+	game.state = GameStateWin
+	h.finishRound(game)
+	return game
+}
+
+func TestHangman_marathon(t *testing.T) {
+	h := newMarathonHangman(t, t.TempDir())
+	words, err := h.WordLoader.GroupWords("sports")
+	if err != nil {
+		t.Fatalf("GroupWords() unexpected error = %v", err)
+	}
+	h.startMarathon(words, []string{"sports"})
+
+	played := []string{}
+	for i := range words {
+		game := playMarathonRound(t, h)
+		played = append(played, game.word.Text)
+		if got, want := h.marathonRun.progress(), fmt.Sprintf("%d/%d", i+1, len(words)); got != want {
+			t.Errorf("progress() = %q, want %q", got, want)
+		}
+	}
+
+	if _, state, err := h.nextMarathonRound(); err != nil || state != GameStatePending {
+		t.Fatalf("nextMarathonRound() after the last word = %v, %q, want the menu", err, state)
+	}
+	if h.marathonRun != nil {
+		t.Error("marathon still going after the last word")
+	}
+
+	want := make([]string, 0, len(words))
+	for _, word := range words {
+		want = append(want, word.Text)
+	}
+	slices.Sort(played)
+	slices.Sort(want)
+	if !slices.Equal(played, want) {
+		t.Errorf("played %v, want every word once: %v", played, want)
+	}
+
+	board, err := ReadLeaderboard(h.leaderboardFile)
+	if err != nil {
+		t.Fatalf("ReadLeaderboard() unexpected error = %v", err)
+	}
+	if top := board.Top(ModeMarathon); len(top) != 1 || top[0].Solved != len(words) || top[0].Score != SummarizeRun(h.results).Score {
+		t.Errorf("marathon leaderboard = %+v, want the marathon with every word solved", top)
+	}
+}
+
+func TestHangman_marathonSave(t *testing.T) {
+	dir := t.TempDir()
+	h := newMarathonHangman(t, dir)
+	words, err := h.WordLoader.GroupWords("sports")
+	if err != nil {
+		t.Fatalf("GroupWords() unexpected error = %v", err)
+	}
+	h.startMarathon(words, []string{"sports"})
+	playMarathonRound(t, h)
+
+	game, _, err := h.nextMarathonRound()
+	if err != nil {
+		t.Fatalf("nextMarathonRound() unexpected error = %v", err)
+	}
+	game.processGuess("z")
+	if err := h.saveGame(game); err != nil {
+		t.Fatalf("saveGame() unexpected error = %v", err)
+	}
+
+	resumed := newMarathonHangman(t, dir)
+	if !slices.ContainsFunc(resumed.menuItems(RootGroup), func(item menuItem) bool { return item.action == menuActionResume }) {
+		t.Error("menuItems() has no resume entry for the saved marathon")
+	}
+	restored, err := resumed.resumeGame()
+	if err != nil {
+		t.Fatalf("resumeGame() unexpected error = %v", err)
+	}
+
+	if restored.word.Text != game.word.Text || !slices.Equal(restored.incorrect, game.incorrect) {
+		t.Errorf("resumed round = %q %v, want %q %v", restored.word.Text, restored.incorrect, game.word.Text, game.incorrect)
+	}
+	if got, want := resumed.marathonRun.progress(), h.marathonRun.progress(); got != want {
+		t.Errorf("progress() = %q, want %q", got, want)
+	}
+	if len(resumed.results) != 1 || resumed.results[0].Score != h.results[0].Score {
+		t.Errorf("results = %+v, want the finished round %+v", resumed.results, h.results)
+	}
+	for i, word := range resumed.marathonRun.words {
+		if word.Text != h.marathonRun.words[i].Text {
+			t.Errorf("word %d = %q, want the saved order %q", i, word.Text, h.marathonRun.words[i].Text)
+		}
+	}
+}
+
+func TestHangman_abandonRound(t *testing.T) {
+	tests := []struct {
+		name  string
+		start func(h *Hangman, words []Word)
+		next  func(h *Hangman) (*HangmanGame, GameState, error)
+		mode  Mode
+	}{
+		{
+			name:  "time attack",
+			start: func(h *Hangman, words []Word) { h.startTimeAttack(words, []string{"sports"}) },
+			next:  (*Hangman).nextAttackRound,
+			mode:  ModeTimeAttack,
+		},
+		{
+			name:  "survival",
+			start: func(h *Hangman, words []Word) { h.startSurvival(words, []string{"sports"}) },
+			next:  (*Hangman).nextSurvivalRound,
+			mode:  ModeSurvival,
+		},
+		{
+			name:  "marathon",
+			start: func(h *Hangman, words []Word) { h.startMarathon(words, []string{"sports"}) },
+			next:  (*Hangman).nextMarathonRound,
+			mode:  ModeMarathon,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newMarathonHangman(t, t.TempDir())
+			h.timeAttack = TimeAttack{Duration: time.Minute}
+			h.survival = Survival{Lives: 5}
+			words, err := h.WordLoader.GroupWords("sports")
+			if err != nil {
+				t.Fatalf("GroupWords() unexpected error = %v", err)
+			}
+			tt.start(h, words)
+
+			game, _, err := tt.next(h)
+			if err != nil {
+				t.Fatalf("next round unexpected error = %v", err)
+			}
+			game.state = GameStateAbandon
+			h.abandonRound(game)
+
+			if h.attack != nil || h.survivalRun != nil || h.marathonRun != nil {
+				t.Error("run still going after the round was abandoned")
+			}
+			board, err := ReadLeaderboard(h.leaderboardFile)
+			if err != nil {
+				t.Fatalf("ReadLeaderboard() unexpected error = %v", err)
+			}
+			if top := board.Top(tt.mode); len(top) != 1 || top[0].Rounds != 1 {
+				t.Errorf("%s leaderboard = %+v, want the abandoned run", tt.mode, top)
+			}
+		})
+	}
+}
+
+func TestHangman_quit(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(h *Hangman, words []Word)
+		next  func(h *Hangman) (*HangmanGame, GameState, error)
+		mode  Mode
+		save  bool
+	}{
+		{
+			name:  "time attack",
+			setup: func(h *Hangman, words []Word) { h.startTimeAttack(words, []string{"sports"}) },
+			next:  (*Hangman).nextAttackRound,
+			mode:  ModeTimeAttack,
+		},
+		{
+			name:  "survival",
+			setup: func(h *Hangman, words []Word) { h.startSurvival(words, []string{"sports"}) },
+			next:  (*Hangman).nextSurvivalRound,
+			mode:  ModeSurvival,
+		},
+		{
+			name:  "marathon",
+			setup: func(h *Hangman, words []Word) { h.startMarathon(words, []string{"sports"}) },
+			next:  (*Hangman).nextMarathonRound,
+			mode:  ModeMarathon,
+		},
+		{
+			name:  "saved marathon",
+			setup: func(h *Hangman, words []Word) { h.startMarathon(words, []string{"sports"}) },
+			next:  (*Hangman).nextMarathonRound,
+			mode:  ModeMarathon,
+			save:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newMarathonHangman(t, t.TempDir())
+			h.timeAttack = TimeAttack{Duration: time.Minute}
+			h.survival = Survival{Lives: 5}
+			words, err := h.WordLoader.GroupWords("sports")
+			if err != nil {
+				t.Fatalf("GroupWords() unexpected error = %v", err)
+			}
+			tt.setup(h, words)
+
+			game, _, err := tt.next(h)
+			if err != nil {
+				t.Fatalf("next round unexpected error = %v", err)
+			}
+			game.solve(game.word.Text)
+			game.state = GameStateWin
+			h.finishRound(game)
+			game, _, err = tt.next(h)
+			if err != nil {
+				t.Fatalf("next round unexpected error = %v", err)
+			}
+			if tt.save {
+				if err := h.saveGame(game); err != nil {
+					t.Fatalf("saveGame() unexpected error = %v", err)
+				}
+			}
+			h.quit()
+
+			board, err := ReadLeaderboard(h.leaderboardFile)
+			if err != nil {
+				t.Fatalf("ReadLeaderboard() unexpected error = %v", err)
+			}
+			top := board.Top(tt.mode)
+			if tt.save {
+				if h.marathonRun == nil || len(top) != 0 || !h.hasSavedGame() {
+					t.Errorf("saved marathon ended on quit: leaderboard = %+v", top)
+				}
+				return
+			}
+			if h.attack != nil || h.survivalRun != nil || h.marathonRun != nil {
+				t.Error("run still going after the game was quit")
+			}
+			if len(top) != 1 || top[0].Solved != 1 {
+				t.Errorf("%s leaderboard = %+v, want the run with 1 word solved", tt.mode, top)
+			}
+		})
+	}
+}
+
+func TestHangman_marathonSaveFinished(t *testing.T) {
+	dir := t.TempDir()
+	h := newMarathonHangman(t, dir)
+	words, err := h.WordLoader.GroupWords("sports")
+	if err != nil {
+		t.Fatalf("GroupWords() unexpected error = %v", err)
+	}
+	h.startMarathon(words, []string{"sports"})
+	playMarathonRound(t, h)
+
+	game, _, err := h.nextMarathonRound()
+	if err != nil {
+		t.Fatalf("nextMarathonRound() unexpected error = %v", err)
+	}
+	if err := h.saveGame(game); err != nil {
+		t.Fatalf("saveGame() unexpected error = %v", err)
+	}
+	game.solve(game.word.Text)
+	game.state = GameStateWin
+	h.finishRound(game)
+
+	if h.hasSavedGame() {
+		t.Fatal("save of a finished marathon round kept, resuming it would count its rounds twice")
+	}
+	if len(h.results) != 2 {
+		t.Errorf("results = %d rounds, want 2", len(h.results))
+	}
+}
+
+func TestHangman_startConfiguredRunSaved(t *testing.T) {
+	dir := t.TempDir()
+	h := newMarathonHangman(t, dir)
+	h.marathon.Categories = []string{"EPL Teams"}
+	game, state, err := h.startConfiguredRun()
+	if err != nil || state != GameStatePlaying {
+		t.Fatalf("startConfiguredRun() = %v, %q, want a round", err, state)
+	}
+	if err := h.saveGame(game); err != nil {
+		t.Fatalf("saveGame() unexpected error = %v", err)
+	}
+
+	resumed := newMarathonHangman(t, dir)
+	resumed.marathon.Categories = []string{"EPL Teams"}
+	resumed.gameState = GameStatePending
+	game, state, err = resumed.startConfiguredRun()
+	if err != nil || game != nil || state != GameStatePending || resumed.marathonRun != nil {
+		t.Fatalf("startConfiguredRun() with a saved game = %v, %v, %q, want the menu", err, game, state)
+	}
+	if !slices.ContainsFunc(resumed.menuItems(RootGroup), func(item menuItem) bool { return item.action == menuActionResume }) {
+		t.Error("menuItems() has no resume entry for the saved marathon")
+	}
+}
@@ -0,0 +1,109 @@
+package hangman
+
+import (
+	"math/bits"
+	"strings"
+	"unicode"
+)
+
+// Difficulty bounds for WordMeta.Difficulty.
+const (
+	MinDifficulty = 1
+	MaxDifficulty = 10
+)
+
+// LetterSet is a bit set of the ASCII letters a-z, case-insensitive.
+type LetterSet uint32
+
+// Add returns the set with r added; runes other than ASCII letters are ignored.
+func (s LetterSet) Add(r rune) LetterSet {
+	r = unicode.ToLower(r)
+	if r < 'a' || r > 'z' {
+		return s
+	}
+	return s | 1<<(r-'a')
+}
+
+// Has reports whether the set contains r, ignoring case.
+func (s LetterSet) Has(r rune) bool {
+	r = unicode.ToLower(r)
+	return r >= 'a' && r <= 'z' && s&(1<<(r-'a')) != 0
+}
+
+// Len returns the number of letters in the set.
+func (s LetterSet) Len() int {
+	return bits.OnesCount32(uint32(s))
+}
+
+// String returns the letters of the set in alphabetical order.
+func (s LetterSet) String() string {
+	builder := new(strings.Builder)
+	for r := 'a'; r <= 'z'; r++ {
+		if s.Has(r) {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+// WordMeta is precomputed information about a word, filled in when the
+// word is loaded so games and indexes do not rescan its text.
+type WordMeta struct {
+	// Length is the number of letters the player has to guess.
+	Length int
+	// Letters is the set of distinct letters in the word.
+	Letters LetterSet
+	// Difficulty estimates how hard the word is, from MinDifficulty to MaxDifficulty.
+	Difficulty int
+	// Indices maps each lowercase character to its byte offsets in the text,
+	// as returned by Word.Indices. It must not be modified.
+	Indices map[string][]int
+	// Answer is the masked answer shown when a round starts, as returned by
+	// Word.PreAnswer. Copy it before revealing letters.
+	Answer []string
+
+	computed bool
+}
+
+// Meta returns the word's precomputed metadata, computing it if the word
+// was not created by a loader.
+func (w *Word) Meta() WordMeta {
+	if w.meta.computed {
+		return w.meta
+	}
+	return computeMeta(w.Text)
+}
+
+// computeMeta scans text once to build its metadata.
+func computeMeta(text string) WordMeta {
+	meta := WordMeta{computed: true}
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			meta.Length++
+			meta.Letters = meta.Letters.Add(r)
+		}
+	}
+	meta.Difficulty = difficulty(meta.Letters)
+	meta.Indices = letterIndices(text)
+	meta.Answer = maskedAnswer(text)
+	return meta
+}
+
+// letterRarity weighs letters by how rarely they appear in English words:
+// rare letters are unlikely to be guessed early.
+var letterRarity = [26]int{
+	// a  b  c  d  e  f  g  h  i  j  k  l  m  n  o  p  q  r  s  t  u  v  w  x  y  z
+	0, 1, 1, 1, 0, 1, 1, 0, 0, 3, 2, 1, 1, 0, 0, 1, 3, 0, 0, 0, 1, 2, 1, 3, 1, 3,
+}
+
+// difficulty estimates how hard a word is to guess. Fewer distinct letters
+// leave fewer correct guesses to find, and rare letters are guessed late.
+func difficulty(letters LetterSet) int {
+	score := MaxDifficulty - min(letters.Len(), 8)
+	for i := range letterRarity {
+		if letters&(1<<i) != 0 {
+			score += letterRarity[i]
+		}
+	}
+	return max(MinDifficulty, min(MaxDifficulty, score))
+}
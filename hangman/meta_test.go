@@ -0,0 +1,85 @@
+package hangman
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLetterSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		letters string
+		want    string
+		wantLen int
+	}{
+		{"empty", "", "", 0},
+		{"case-insensitive", "aAbB", "ab", 2},
+		{"ignores non-letters", "a b-c!1", "abc", 3},
+		{"ignores non-ASCII", "éa", "a", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var set LetterSet
+			for _, r := range tt.letters {
+				set = set.Add(r)
+			}
+			if got := set.String(); got != tt.want {
+				t.Errorf("LetterSet.String() = %q, want %q", got, tt.want)
+			}
+			if got := set.Len(); got != tt.wantLen {
+				t.Errorf("LetterSet.Len() = %d, want %d", got, tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestWord_Meta(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		wantLength  int
+		wantLetters string
+	}{
+		{"single word", "Apple", 5, "aelp"},
+		{"multiple words", "Ice Cream", 8, "aceimr"},
+		{"punctuation", "Rock'n'Roll", 9, "cklnor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			word := Word{Text: tt.text}
+			meta := word.Meta()
+			if meta.Length != tt.wantLength {
+				t.Errorf("Meta().Length = %d, want %d", meta.Length, tt.wantLength)
+			}
+			if got := meta.Letters.String(); got != tt.wantLetters {
+				t.Errorf("Meta().Letters = %q, want %q", got, tt.wantLetters)
+			}
+			if meta.Length != word.AlphabetLength() {
+				t.Errorf("Meta().Length = %d, want AlphabetLength() %d", meta.Length, word.AlphabetLength())
+			}
+			if !reflect.DeepEqual(meta.Indices, word.Indices()) {
+				t.Errorf("Meta().Indices = %v, want Indices() %v", meta.Indices, word.Indices())
+			}
+			if !reflect.DeepEqual(meta.Answer, word.PreAnswer()) {
+				t.Errorf("Meta().Answer = %q, want PreAnswer() %q", meta.Answer, word.PreAnswer())
+			}
+		})
+	}
+}
+
+func TestDifficulty(t *testing.T) {
+	easy := computeMeta("Banana").Difficulty
+	hard := computeMeta("Jazz").Difficulty
+	if easy >= hard {
+		t.Errorf("difficulty(Banana) = %d, want less than difficulty(Jazz) = %d", easy, hard)
+	}
+
+	for _, text := range []string{"", "a", "Quizzical Jukebox", "abcdefghijklmnopqrstuvwxyz"} {
+		got := computeMeta(text).Difficulty
+		if got < MinDifficulty || got > MaxDifficulty {
+			t.Errorf("difficulty(%q) = %d, want between %d and %d", text, got, MinDifficulty, MaxDifficulty)
+		}
+	}
+}
@@ -0,0 +1,520 @@
+package hangman
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const (
+	// ManifestFile is the name of the manifest at the root of every word pack.
+	ManifestFile = "pack.json"
+	// disabledMarker is created inside an installed pack to disable it.
+	disabledMarker = ".disabled"
+)
+
+var packNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// Manifest describes a word pack and the word files it ships.
+type Manifest struct {
+	Name        string   `json:"name"`
+	Version     string   `json:"version"`
+	Author      string   `json:"author"`
+	Description string   `json:"description,omitempty"`
+	Files       []string `json:"files"`
+	// SHA256 maps each listed file to the hex-encoded SHA-256 of its content.
+	SHA256 map[string]string `json:"sha256,omitempty"`
+	// Signature is the base64 ed25519 signature of SigningPayload.
+	Signature string `json:"signature,omitempty"`
+}
+
+// Validate checks that the manifest has a usable name and a safe file list.
+func (m *Manifest) Validate() error {
+	if !packNamePattern.MatchString(m.Name) {
+		return fmt.Errorf("invalid pack name %q", m.Name)
+	}
+	if m.Version == "" {
+		return errors.New("pack version cannot be empty")
+	}
+	if len(m.Files) == 0 {
+		return errors.New("pack must list at least one file")
+	}
+	for _, name := range m.Files {
+		if !fs.ValidPath(name) || name == "." || name == ManifestFile {
+			return fmt.Errorf("invalid file path %q in manifest", name)
+		}
+	}
+	for name := range m.SHA256 {
+		if !slices.Contains(m.Files, name) {
+			return fmt.Errorf("checksum for unlisted file %q in manifest", name)
+		}
+	}
+	return nil
+}
+
+// ReadManifest reads and validates the manifest at the root of fsys.
+func ReadManifest(fsys fs.FS) (*Manifest, error) {
+	data, err := fs.ReadFile(fsys, ManifestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := new(Manifest)
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", ManifestFile, err)
+	}
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// LoadPack loads the word files listed in the manifest of the pack installed
+// at dir. The pack is verified against the loader's PackPolicy first, so no
+// word from a tampered or, when required, unsigned pack is ever served.
+func (l *WordLoader) LoadPack(dir string) error {
+	manifest, files, err := readPack(dir)
+	if err == nil {
+		err = l.policy.Verify(manifest, files)
+	}
+	if err == nil {
+		// Checksums are declared by the pack itself, so a signature nobody
+		// checked proves nothing.
+		if manifest.Signature != "" && len(l.policy.TrustedKeys) == 0 {
+			l.diagnostics = append(l.diagnostics, Diagnostic{
+				Path:   filepath.Join(dir, ManifestFile),
+				Reason: fmt.Sprintf("pack %s is signed but no trusted keys are configured, its signature was not checked", manifest.Name),
+			})
+		}
+		return l.loadPackFiles(dir, manifest, files)
+	}
+
+	diag := l.report(Diagnostic{Path: filepath.Join(dir, ManifestFile), Reason: err.Error()})
+	if l.mode == LoadModeLenient {
+		return nil
+	}
+	return diag
+}
+
+// loadPackFiles parses the verified contents of a pack.
+func (l *WordLoader) loadPackFiles(dir string, manifest *Manifest, files map[string][]byte) error {
+	for _, name := range manifest.Files {
+		parser := l.newParser()
+		wf, err := parser.parseData(files[name], filepath.Join(dir, filepath.FromSlash(name)))
+		l.diagnostics = append(l.diagnostics, parser.diagnostics...)
+		if err != nil {
+			if l.mode == LoadModeLenient {
+				continue
+			}
+			return err
+		}
+
+		l.addCategory(groupOf(".", name), wf)
+	}
+
+	return nil
+}
+
+// InstalledPack is a word pack present in a PackStore.
+type InstalledPack struct {
+	Manifest
+	Dir     string `json:"dir"`
+	Enabled bool   `json:"enabled"`
+}
+
+// PackStore manages the word packs installed in a directory, one
+// subdirectory per pack named after the manifest.
+type PackStore struct {
+	dir string
+}
+
+// NewPackStore creates a PackStore rooted at dir.
+func NewPackStore(dir string) *PackStore {
+	return &PackStore{dir: dir}
+}
+
+// DefaultPacksDir returns the per-user directory where packs are installed.
+func DefaultPacksDir() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "hangman", "packs"), nil
+}
+
+// Dir returns the directory the store manages.
+func (s *PackStore) Dir() string {
+	return s.dir
+}
+
+// Install unpacks a .zip, .tar, .tar.gz or .tgz archive into the store,
+// replacing any installed pack with the same name. Checksums in the manifest
+// must match and every listed word file must load in strict mode before the
+// pack is installed.
+func (s *PackStore) Install(archive string) (*InstalledPack, error) {
+	files, err := readArchive(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := parsePackFiles(files)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	staging, err := os.MkdirTemp(s.dir, ".install-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(staging)
+
+	for _, name := range append([]string{ManifestFile}, manifest.Files...) {
+		target := filepath.Join(staging, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(target, files[name], 0o644); err != nil {
+			return nil, err
+		}
+	}
+
+	// A reinstalled pack stays disabled if it was.
+	dest := filepath.Join(s.dir, manifest.Name)
+	_, err = os.Stat(filepath.Join(dest, disabledMarker))
+	enabled := errors.Is(err, fs.ErrNotExist)
+	if !enabled {
+		if err := os.WriteFile(filepath.Join(staging, disabledMarker), nil, 0o644); err != nil {
+			return nil, err
+		}
+	}
+
+	// The installed pack is moved aside, not deleted, until the new one is in
+	// place, so a failed rename leaves it installed.
+	backup := ""
+	if _, err := os.Stat(dest); err == nil {
+		backup = staging + ".old"
+		if err := os.Rename(dest, backup); err != nil {
+			return nil, err
+		}
+	}
+	if err := os.Rename(staging, dest); err != nil {
+		if backup != "" {
+			if restoreErr := os.Rename(backup, dest); restoreErr != nil {
+				return nil, errors.Join(err, restoreErr)
+			}
+		}
+		return nil, err
+	}
+	if backup != "" {
+		if err := os.RemoveAll(backup); err != nil {
+			return nil, err
+		}
+	}
+
+	return &InstalledPack{Manifest: *manifest, Dir: dest, Enabled: enabled}, nil
+}
+
+// parsePackFiles validates the manifest and word files of an unpacked archive.
+func parsePackFiles(files map[string][]byte) (*Manifest, error) {
+	data, ok := files[ManifestFile]
+	if !ok {
+		return nil, fmt.Errorf("archive has no %s", ManifestFile)
+	}
+
+	manifest := new(Manifest)
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", ManifestFile, err)
+	}
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+
+	parser := NewWordLoader().newParser()
+	for _, name := range manifest.Files {
+		content, ok := files[name]
+		if !ok {
+			return nil, fmt.Errorf("file %q listed in manifest is missing from archive", name)
+		}
+		// Encrypted files can only be checked once a key is available at load time.
+		if IsEncrypted(content) {
+			continue
+		}
+		if _, err := parser.parse(bytes.NewReader(content), name); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := (PackPolicy{}).Verify(manifest, files); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// List returns every installed pack sorted by name. Directories with an
+// invalid name or manifest are skipped and reported as diagnostics.
+func (s *PackStore) List() ([]InstalledPack, Diagnostics, error) {
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []InstalledPack{}, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	packs := []InstalledPack{}
+	var diags Diagnostics
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		pack, err := s.Info(entry.Name())
+		if err != nil {
+			diags = append(diags, Diagnostic{Path: filepath.Join(s.dir, entry.Name()), Reason: err.Error()})
+			continue
+		}
+		packs = append(packs, *pack)
+	}
+
+	sort.Slice(packs, func(i, j int) bool {
+		return packs[i].Name < packs[j].Name
+	})
+	return packs, diags, nil
+}
+
+// Info returns the installed pack with the given name.
+func (s *PackStore) Info(name string) (*InstalledPack, error) {
+	dir, err := s.packDir(name)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := ReadManifest(os.DirFS(dir))
+	if err != nil {
+		return nil, fmt.Errorf("pack %s: %w", name, err)
+	}
+
+	_, err = os.Stat(filepath.Join(dir, disabledMarker))
+	return &InstalledPack{Manifest: *manifest, Dir: dir, Enabled: errors.Is(err, fs.ErrNotExist)}, nil
+}
+
+// Enable makes an installed pack load again.
+func (s *PackStore) Enable(name string) error {
+	dir, err := s.packDir(name)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filepath.Join(dir, disabledMarker))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Disable keeps a pack installed but stops it from loading.
+func (s *PackStore) Disable(name string) error {
+	dir, err := s.packDir(name)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, disabledMarker), nil, 0o644)
+}
+
+// Remove deletes an installed pack.
+func (s *PackStore) Remove(name string) error {
+	dir, err := s.packDir(name)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(dir)
+}
+
+// LoadInto loads every enabled pack into the word loader. Broken pack
+// directories are reported as diagnostics; in lenient mode they are skipped.
+func (s *PackStore) LoadInto(l *WordLoader) error {
+	packs, diags, err := s.List()
+	if err != nil {
+		return err
+	}
+	for _, diag := range diags {
+		if err := l.report(diag); l.mode != LoadModeLenient {
+			return err
+		}
+	}
+
+	for _, pack := range packs {
+		if !pack.Enabled {
+			continue
+		}
+		if err := l.LoadPack(pack.Dir); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// packDir returns the directory of an installed pack, failing if it does not exist.
+func (s *PackStore) packDir(name string) (string, error) {
+	if !packNamePattern.MatchString(name) {
+		return "", fmt.Errorf("invalid pack name %q", name)
+	}
+
+	dir := filepath.Join(s.dir, name)
+	if _, err := os.Stat(dir); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("pack %s is not installed", name)
+		}
+		return "", err
+	}
+
+	return dir, nil
+}
+
+// readArchive reads every regular file of a zip or tar archive into memory.
+// When the archive wraps its content in a single top-level directory, that
+// directory is stripped so the manifest ends up at the root.
+func readArchive(archive string) (map[string][]byte, error) {
+	var (
+		files map[string][]byte
+		err   error
+	)
+
+	switch lower := strings.ToLower(archive); {
+	case strings.HasSuffix(lower, ".zip"):
+		files, err = readZip(archive)
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		files, err = readTar(archive, true)
+	case strings.HasSuffix(lower, ".tar"):
+		files, err = readTar(archive, false)
+	default:
+		return nil, fmt.Errorf("unsupported archive format %q, expected .zip, .tar, .tar.gz or .tgz", filepath.Base(archive))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := files[ManifestFile]; ok {
+		return files, nil
+	}
+
+	var prefix string
+	for name := range files {
+		if path.Base(name) == ManifestFile && strings.Count(name, "/") == 1 {
+			prefix = path.Dir(name) + "/"
+			break
+		}
+	}
+	if prefix == "" {
+		return files, nil
+	}
+
+	stripped := make(map[string][]byte, len(files))
+	for name, content := range files {
+		if rel, ok := strings.CutPrefix(name, prefix); ok {
+			stripped[rel] = content
+		}
+	}
+	return stripped, nil
+}
+
+// readZip reads the regular files of a zip archive.
+func readZip(archive string) (map[string][]byte, error) {
+	reader, err := zip.OpenReader(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	files := make(map[string][]byte)
+	for _, file := range reader.File {
+		if !file.Mode().IsRegular() {
+			continue
+		}
+		if !fs.ValidPath(file.Name) {
+			return nil, fmt.Errorf("unsafe path %q in archive", file.Name)
+		}
+
+		rc, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		files[file.Name] = content
+	}
+
+	return files, nil
+}
+
+// readTar reads the regular files of a tar archive, optionally gzip-compressed.
+func readTar(archive string, compressed bool) (map[string][]byte, error) {
+	file, err := os.Open(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var r io.Reader = file
+	if compressed {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	files := make(map[string][]byte)
+	reader := tar.NewReader(r)
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := strings.TrimPrefix(header.Name, "./")
+		if !fs.ValidPath(name) {
+			return nil, fmt.Errorf("unsafe path %q in archive", header.Name)
+		}
+
+		content, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+		files[name] = content
+	}
+
+	return files, nil
+}
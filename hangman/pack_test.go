@@ -0,0 +1,321 @@
+package hangman
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const testManifest = `{"name": "birds", "version": "1.0.0", "author": "Tester", "files": ["birds.txt", "sea/gulls.txt"]}`
+
+func testPackFiles() map[string]string {
+	return map[string]string{
+		ManifestFile:    testManifest,
+		"birds.txt":     "Birds\nEagle,A bird of prey\nOwl,Hoots at night\n",
+		"sea/gulls.txt": "Gulls\nHerring Gull,Steals chips\n",
+	}
+}
+
+func writeZip(t *testing.T, archive, prefix string, files map[string]string) {
+	t.Helper()
+	out, err := os.Create(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+
+	writer := zip.NewWriter(out)
+	for name, content := range files {
+		w, err := writer.Create(prefix + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeTarGz(t *testing.T, archive string, files map[string]string) {
+	t.Helper()
+	out, err := os.Create(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+
+	gz := gzip.NewWriter(out)
+	writer := tar.NewWriter(gz)
+	for name, content := range files {
+		header := &tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}
+		if err := writer.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := writer.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestManifest_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest Manifest
+		wantErr  bool
+	}{
+		{"valid", Manifest{Name: "birds", Version: "1", Files: []string{"a.txt"}}, false},
+		{"empty name", Manifest{Version: "1", Files: []string{"a.txt"}}, true},
+		{"name with slash", Manifest{Name: "a/b", Version: "1", Files: []string{"a.txt"}}, true},
+		{"missing version", Manifest{Name: "birds", Files: []string{"a.txt"}}, true},
+		{"no files", Manifest{Name: "birds", Version: "1"}, true},
+		{"parent path", Manifest{Name: "birds", Version: "1", Files: []string{"../a.txt"}}, true},
+		{"absolute path", Manifest{Name: "birds", Version: "1", Files: []string{"/a.txt"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.manifest.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Manifest.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPackStore_Install(t *testing.T) {
+	tests := []struct {
+		name  string
+		write func(t *testing.T, archive string)
+		file  string
+	}{
+		{
+			name:  "zip",
+			file:  "birds.zip",
+			write: func(t *testing.T, archive string) { writeZip(t, archive, "", testPackFiles()) },
+		},
+		{
+			name:  "zip with top-level directory",
+			file:  "birds-wrapped.zip",
+			write: func(t *testing.T, archive string) { writeZip(t, archive, "birds-1.0.0/", testPackFiles()) },
+		},
+		{
+			name:  "tar.gz",
+			file:  "birds.tar.gz",
+			write: func(t *testing.T, archive string) { writeTarGz(t, archive, testPackFiles()) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmp := t.TempDir()
+			archive := filepath.Join(tmp, tt.file)
+			tt.write(t, archive)
+
+			store := NewPackStore(filepath.Join(tmp, "packs"))
+			pack, err := store.Install(archive)
+			if err != nil {
+				t.Fatalf("PackStore.Install() unexpected error = %v", err)
+			}
+			if pack.Name != "birds" || pack.Version != "1.0.0" || !pack.Enabled {
+				t.Errorf("PackStore.Install() = %+v, want enabled birds 1.0.0", pack)
+			}
+
+			if _, err := os.Stat(filepath.Join(pack.Dir, "sea", "gulls.txt")); err != nil {
+				t.Errorf("PackStore.Install() did not extract nested file: %v", err)
+			}
+		})
+	}
+}
+
+func TestPackStore_InstallInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		file  string
+		files map[string]string
+	}{
+		{
+			name:  "missing manifest",
+			file:  "a.zip",
+			files: map[string]string{"birds.txt": "Birds\nEagle,A bird of prey\n"},
+		},
+		{
+			name:  "missing listed file",
+			file:  "b.zip",
+			files: map[string]string{ManifestFile: testManifest, "birds.txt": "Birds\nEagle,A bird of prey\n"},
+		},
+		{
+			name: "malformed word file",
+			file: "c.zip",
+			files: map[string]string{
+				ManifestFile:    testManifest,
+				"birds.txt":     "Birds\n,A bird of prey\n",
+				"sea/gulls.txt": "Gulls\nHerring Gull,Steals chips\n",
+			},
+		},
+		{
+			name:  "unsupported format",
+			file:  "d.rar",
+			files: testPackFiles(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmp := t.TempDir()
+			archive := filepath.Join(tmp, tt.file)
+			writeZip(t, archive, "", tt.files)
+
+			store := NewPackStore(filepath.Join(tmp, "packs"))
+			if _, err := store.Install(archive); err == nil {
+				t.Error("PackStore.Install() expected error")
+			}
+
+			packs, _, err := store.List()
+			if err != nil {
+				t.Fatalf("PackStore.List() unexpected error = %v", err)
+			}
+			if len(packs) != 0 {
+				t.Errorf("PackStore.List() = %v, want no packs after failed install", packs)
+			}
+		})
+	}
+}
+
+func TestPackStore_Manage(t *testing.T) {
+	tmp := t.TempDir()
+	archive := filepath.Join(tmp, "birds.zip")
+	writeZip(t, archive, "", testPackFiles())
+
+	store := NewPackStore(filepath.Join(tmp, "packs"))
+	if _, err := store.Install(archive); err != nil {
+		t.Fatalf("PackStore.Install() unexpected error = %v", err)
+	}
+
+	loader := NewWordLoader()
+	if err := store.LoadInto(loader); err != nil {
+		t.Fatalf("PackStore.LoadInto() unexpected error = %v", err)
+	}
+	if !reflect.DeepEqual(loader.Categories(), []string{"Birds", "Gulls"}) {
+		t.Errorf("WordLoader.Categories() = %v, want [Birds Gulls]", loader.Categories())
+	}
+	if group := loader.CategoryGroup("Gulls"); group != "sea" {
+		t.Errorf("WordLoader.CategoryGroup() = %q, want sea", group)
+	}
+
+	if err := store.Disable("birds"); err != nil {
+		t.Fatalf("PackStore.Disable() unexpected error = %v", err)
+	}
+	pack, err := store.Info("birds")
+	if err != nil {
+		t.Fatalf("PackStore.Info() unexpected error = %v", err)
+	}
+	if pack.Enabled {
+		t.Error("PackStore.Info() pack should be disabled")
+	}
+
+	loader = NewWordLoader()
+	if err := store.LoadInto(loader); err != nil {
+		t.Fatalf("PackStore.LoadInto() unexpected error = %v", err)
+	}
+	if len(loader.Categories()) != 0 {
+		t.Errorf("PackStore.LoadInto() loaded %v from a disabled pack", loader.Categories())
+	}
+
+	if err := store.Enable("birds"); err != nil {
+		t.Fatalf("PackStore.Enable() unexpected error = %v", err)
+	}
+	if pack, _ := store.Info("birds"); !pack.Enabled {
+		t.Error("PackStore.Info() pack should be enabled")
+	}
+
+	if err := store.Remove("birds"); err != nil {
+		t.Fatalf("PackStore.Remove() unexpected error = %v", err)
+	}
+	if _, err := store.Info("birds"); err == nil {
+		t.Error("PackStore.Info() expected error after remove")
+	}
+	if err := store.Disable("../birds"); err == nil {
+		t.Error("PackStore.Disable() expected error for invalid name")
+	}
+}
+
+func TestPackStore_Reinstall(t *testing.T) {
+	tmp := t.TempDir()
+	archive := filepath.Join(tmp, "birds.zip")
+	writeZip(t, archive, "", testPackFiles())
+
+	store := NewPackStore(filepath.Join(tmp, "packs"))
+	if _, err := store.Install(archive); err != nil {
+		t.Fatalf("PackStore.Install() unexpected error = %v", err)
+	}
+	if err := store.Disable("birds"); err != nil {
+		t.Fatalf("PackStore.Disable() unexpected error = %v", err)
+	}
+
+	pack, err := store.Install(archive)
+	if err != nil {
+		t.Fatalf("PackStore.Install() again unexpected error = %v", err)
+	}
+	if info, err := store.Info("birds"); err != nil || pack.Enabled || info.Enabled {
+		t.Errorf("reinstalled pack enabled = %v, info = %+v, %v, want it to stay disabled", pack.Enabled, info, err)
+	}
+
+	entries, err := os.ReadDir(store.Dir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("store contains %v, want only the reinstalled pack", entries)
+	}
+}
+
+func TestPackStore_ListBroken(t *testing.T) {
+	tmp := t.TempDir()
+	archive := filepath.Join(tmp, "birds.zip")
+	writeZip(t, archive, "", testPackFiles())
+
+	store := NewPackStore(filepath.Join(tmp, "packs"))
+	if _, err := store.Install(archive); err != nil {
+		t.Fatalf("PackStore.Install() unexpected error = %v", err)
+	}
+	for _, dir := range []string{"Bad Name", "nomanifest"} {
+		if err := os.Mkdir(filepath.Join(store.Dir(), dir), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	packs, diags, err := store.List()
+	if err != nil {
+		t.Fatalf("PackStore.List() unexpected error = %v", err)
+	}
+	if len(packs) != 1 || packs[0].Name != "birds" || len(diags) != 2 {
+		t.Errorf("PackStore.List() = %v, %v, want birds and 2 diagnostics", packs, diags)
+	}
+
+	loader := NewWordLoader()
+	if err := store.LoadInto(loader); err == nil {
+		t.Error("PackStore.LoadInto() in strict mode, want error")
+	}
+
+	loader = NewWordLoader()
+	loader.SetMode(LoadModeLenient)
+	if err := store.LoadInto(loader); err != nil {
+		t.Fatalf("PackStore.LoadInto() in lenient mode unexpected error = %v", err)
+	}
+	if !reflect.DeepEqual(loader.Categories(), []string{"Birds", "Gulls"}) || len(loader.Diagnostics()) != 2 {
+		t.Errorf("PackStore.LoadInto() = %v, %v, want the good pack and 2 diagnostics", loader.Categories(), loader.Diagnostics())
+	}
+}
@@ -0,0 +1,79 @@
+package hangman
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultPlaysFile returns the per-user play counts used by OrderMostPlayed,
+// e.g. ~/.config/hangman/plays.json.
+func DefaultPlaysFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "hangman", "plays.json"), nil
+}
+
+// PlayCounts returns the number of games recorded for every category.
+func (l *WordLoader) PlayCounts() map[string]int {
+	return maps.Clone(l.plays)
+}
+
+// SetPlayCounts replaces the recorded play counts, e.g. with counts saved by
+// an earlier session.
+func (l *WordLoader) SetPlayCounts(plays map[string]int) {
+	l.plays = maps.Clone(plays)
+	if l.plays == nil {
+		l.plays = make(map[string]int)
+	}
+}
+
+// ReadPlayCounts reads play counts from path; a missing file has no plays.
+func ReadPlayCounts(path string) (map[string]int, error) {
+	plays := make(map[string]int)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return plays, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &plays); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return plays, nil
+}
+
+// WritePlayCounts writes play counts to path, creating its directory.
+func WritePlayCounts(path string, plays map[string]int) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(plays, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, append(data, '\n'), 0o644)
+}
+
+// recordPlay counts a game played in the category and persists the counts,
+// so OrderMostPlayed survives restarts.
+func (h *Hangman) recordPlay(category string) {
+	h.WordLoader.RecordPlay(category)
+	if h.playsFile == "" {
+		return
+	}
+	if err := WritePlayCounts(h.playsFile, h.WordLoader.PlayCounts()); err != nil {
+		logrus.WithError(err).Warn("failed to save the play counts")
+	}
+}
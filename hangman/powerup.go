@@ -0,0 +1,190 @@
+package hangman
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PowerUpCommand is entered at the guess prompt followed by a PowerUp, e.g. "! vowels".
+const PowerUpCommand = "!"
+
+// EliminateCount is how many wrong letters PowerUpEliminate rules out.
+const EliminateCount = 3
+
+// PowerUp names help a player can buy during a round.
+type PowerUp string
+
+const (
+	// PowerUpReveal reveals every occurrence of a random hidden letter.
+	PowerUpReveal = PowerUp("reveal")
+	// PowerUpVowels reveals every hidden vowel.
+	PowerUpVowels = PowerUp("vowels")
+	// PowerUpEliminate rules out letters that are not in the word.
+	PowerUpEliminate = PowerUp("eliminate")
+	// PowerUpFirstLetters reveals the letters that start a word of the answer,
+	// wherever they appear.
+	PowerUpFirstLetters = PowerUp("first")
+)
+
+// PowerUps lists every power-up in the order they are offered.
+var PowerUps = []PowerUp{PowerUpReveal, PowerUpVowels, PowerUpEliminate, PowerUpFirstLetters}
+
+// PowerUpRule limits and prices a power-up.
+type PowerUpRule struct {
+	// Limit is how many times the power-up can be used per round; zero disables it.
+	Limit int
+	Cost  Cost
+}
+
+// DefaultPowerUpRules returns the power-ups available when no policy is configured.
+func DefaultPowerUpRules() map[PowerUp]PowerUpRule {
+	return map[PowerUp]PowerUpRule{
+		PowerUpReveal:       {Limit: 1, Cost: Cost{Points: 10}},
+		PowerUpVowels:       {Limit: 1, Cost: Cost{Points: 15}},
+		PowerUpEliminate:    {Limit: 1, Cost: Cost{Points: 5}},
+		PowerUpFirstLetters: {Limit: 1, Cost: Cost{Points: 10}},
+	}
+}
+
+// usePowerUp applies a power-up after checking its limit and charging its cost.
+// Nothing is charged when the power-up would have no effect.
+func (g *HangmanGame) usePowerUp(powerUp PowerUp) error {
+	if !slices.Contains(PowerUps, powerUp) {
+		return fmt.Errorf("unknown power-up %q, expected one of: %s", powerUp, powerUpList())
+	}
+
+	rule := g.scoring.PowerUps[powerUp]
+	if g.powerUpsUsed[powerUp] >= rule.Limit {
+		if rule.Limit == 0 {
+			return fmt.Errorf("the %s power-up is disabled", powerUp)
+		}
+		return fmt.Errorf("the %s power-up can only be used %d time(s) per round", powerUp, rule.Limit)
+	}
+
+	var apply func()
+	switch powerUp {
+	case PowerUpReveal:
+		hidden := g.hiddenLetters()
+		if len(hidden) == 0 {
+			return errors.New("there are no hidden letters left")
+		}
+		letter := hidden[rand.Intn(len(hidden))]
+		apply = func() { g.revealLetter(letter) }
+	case PowerUpVowels:
+		vowels := slices.DeleteFunc(g.hiddenLetters(), func(letter string) bool {
+			return !strings.Contains("aeiou", letter)
+		})
+		if len(vowels) == 0 {
+			return errors.New("there are no hidden vowels")
+		}
+		apply = func() {
+			for _, vowel := range vowels {
+				g.revealLetter(vowel)
+			}
+		}
+	case PowerUpEliminate:
+		candidates := []string{}
+		for r := 'a'; r <= 'z'; r++ {
+			letter := string(r)
+			if _, ok := g.wordIndices[letter]; !ok && !g.guesses[letter] && !g.eliminated[letter] {
+				candidates = append(candidates, letter)
+			}
+		}
+		if len(candidates) == 0 {
+			return errors.New("there are no letters left to eliminate")
+		}
+		rand.Shuffle(len(candidates), func(i, j int) { candidates[i], candidates[j] = candidates[j], candidates[i] })
+		apply = func() {
+			for _, letter := range candidates[:min(EliminateCount, len(candidates))] {
+				g.eliminated[letter] = true
+			}
+		}
+	case PowerUpFirstLetters:
+		positions := g.firstLetterPositions()
+		if len(positions) == 0 {
+			return errors.New("the first letters are already revealed")
+		}
+		apply = func() {
+			for _, pos := range positions {
+				first, _ := utf8.DecodeRuneInString(g.word.Text[pos:])
+				g.revealLetter(strings.ToLower(string(first)))
+			}
+		}
+	}
+
+	if err := g.pay(rule.Cost, fmt.Sprintf("power-up %s", powerUp)); err != nil {
+		return err
+	}
+	apply()
+	g.powerUpsUsed[powerUp]++
+	return nil
+}
+
+// hiddenLetters returns the letters of the word not revealed yet, sorted.
+func (g *HangmanGame) hiddenLetters() []string {
+	letters := []string{}
+	for letter, locs := range g.wordIndices {
+		if !IsAlphabet(letter) {
+			continue
+		}
+		for _, loc := range locs {
+			if g.answer[loc] == "_" {
+				letters = append(letters, letter)
+				break
+			}
+		}
+	}
+	slices.Sort(letters)
+	return letters
+}
+
+// revealLetter uncovers every occurrence of letter without scoring it.
+func (g *HangmanGame) revealLetter(letter string) {
+	for _, loc := range g.wordIndices[letter] {
+		if g.answer[loc] == "_" {
+			g.correctCount++
+		}
+		g.answer[loc] = letter
+	}
+	g.guesses[letter] = true
+}
+
+// firstLetterPositions returns the hidden positions that start a word of the answer.
+func (g *HangmanGame) firstLetterPositions() []int {
+	positions := []int{}
+	start := true
+	for i, r := range g.word.Text {
+		letter := unicode.IsLetter(r)
+		if letter && start && g.answer[i] == "_" {
+			positions = append(positions, i)
+		}
+		start = !letter
+	}
+	return positions
+}
+
+// availablePowerUps describes the power-ups that can still be used this round.
+func (g *HangmanGame) availablePowerUps() []string {
+	available := []string{}
+	for _, powerUp := range PowerUps {
+		rule := g.scoring.PowerUps[powerUp]
+		if left := rule.Limit - g.powerUpsUsed[powerUp]; left > 0 {
+			available = append(available, fmt.Sprintf("%s %s (%s, %d left)", PowerUpCommand, powerUp, rule.Cost, left))
+		}
+	}
+	return available
+}
+
+// powerUpList joins PowerUps for messages.
+func powerUpList() string {
+	names := make([]string, 0, len(PowerUps))
+	for _, powerUp := range PowerUps {
+		names = append(names, string(powerUp))
+	}
+	return strings.Join(names, ", ")
+}
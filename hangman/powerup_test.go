@@ -0,0 +1,126 @@
+package hangman
+
+import (
+	"strings"
+	"testing"
+)
+
+func newPowerUpGame(t *testing.T, rules map[PowerUp]PowerUpRule) *HangmanGame {
+	t.Helper()
+	game, err := NewHangmanGame(&Word{Text: "Ice Cream", Hint: "Frozen dessert"}, 3)
+	if err != nil {
+		t.Fatalf("NewHangmanGame() unexpected error = %v", err)
+	}
+	game.SetScoring(Scoring{PointsPerCorrectGuess: 10, PowerUps: rules})
+	return game
+}
+
+func TestHangmanGame_usePowerUp(t *testing.T) {
+	free := map[PowerUp]PowerUpRule{
+		PowerUpReveal:       {Limit: 1},
+		PowerUpVowels:       {Limit: 1},
+		PowerUpEliminate:    {Limit: 1},
+		PowerUpFirstLetters: {Limit: 1},
+	}
+
+	tests := []struct {
+		name       string
+		powerUp    PowerUp
+		wantAnswer string
+	}{
+		{"vowels", PowerUpVowels, "i _ e   _ _ e a _"},
+		{"first letters", PowerUpFirstLetters, "i c _   c _ _ _ _"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := newPowerUpGame(t, free)
+			if err := game.usePowerUp(tt.powerUp); err != nil {
+				t.Fatalf("usePowerUp() unexpected error = %v", err)
+			}
+			if got := strings.Join(game.answer, " "); got != tt.wantAnswer {
+				t.Errorf("answer = %q, want %q", got, tt.wantAnswer)
+			}
+			if game.score != 0 || game.streak != 0 {
+				t.Errorf("score, streak = %d, %d, want revealed letters not to score", game.score, game.streak)
+			}
+			if err := game.usePowerUp(tt.powerUp); err == nil {
+				t.Error("usePowerUp() over the limit, want error")
+			}
+		})
+	}
+
+	t.Run("reveal", func(t *testing.T) {
+		game := newPowerUpGame(t, free)
+		if err := game.usePowerUp(PowerUpReveal); err != nil {
+			t.Fatalf("usePowerUp() unexpected error = %v", err)
+		}
+		if game.correctCount == 0 || len(game.hiddenLetters()) != 5 {
+			t.Errorf("hidden letters = %v, want one of six letters revealed", game.hiddenLetters())
+		}
+	})
+
+	t.Run("eliminate", func(t *testing.T) {
+		game := newPowerUpGame(t, free)
+		if err := game.usePowerUp(PowerUpEliminate); err != nil {
+			t.Fatalf("usePowerUp() unexpected error = %v", err)
+		}
+		if len(game.eliminated) != EliminateCount {
+			t.Fatalf("eliminated = %v, want %d letters", game.eliminated, EliminateCount)
+		}
+		for letter := range game.eliminated {
+			if _, ok := game.wordIndices[letter]; ok {
+				t.Errorf("eliminated %q, which is in the word", letter)
+			}
+			game.processGuess(letter)
+			if game.remaining != 11 {
+				t.Errorf("remaining = %d after guessing an eliminated letter, want 11", game.remaining)
+			}
+		}
+	})
+
+	t.Run("first letters then guess", func(t *testing.T) {
+		game := newPowerUpGame(t, free)
+		game.usePowerUp(PowerUpFirstLetters)
+		game.processGuess("c")
+		if game.score != 0 {
+			t.Errorf("score = %d after guessing a revealed letter, want 0", game.score)
+		}
+		for _, letter := range []string{"e", "r", "a", "m"} {
+			game.processGuess(letter)
+		}
+		if !game.isWin() {
+			t.Errorf("isWin() = false after guessing every letter, correctCount = %d", game.correctCount)
+		}
+	})
+}
+
+func TestHangmanGame_usePowerUpRules(t *testing.T) {
+	game := newPowerUpGame(t, map[PowerUp]PowerUpRule{
+		PowerUpVowels: {Limit: 2, Cost: Cost{Points: 15, Lives: 1}},
+	})
+	game.processGuess("c")
+	game.processGuess("r")
+
+	if err := game.usePowerUp(PowerUpReveal); err == nil {
+		t.Error("usePowerUp() disabled power-up, want error")
+	}
+	if err := game.usePowerUp(PowerUp("teleport")); err == nil {
+		t.Error("usePowerUp() unknown power-up, want error")
+	}
+	if err := game.usePowerUp(PowerUpVowels); err != nil {
+		t.Fatalf("usePowerUp() unexpected error = %v", err)
+	}
+	if game.score != 15 || game.remaining != 10 {
+		t.Errorf("score, remaining = %d, %d, want 15, 10", game.score, game.remaining)
+	}
+	if err := game.usePowerUp(PowerUpVowels); err == nil {
+		t.Error("usePowerUp() with no hidden vowels, want error")
+	}
+	if game.score != 15 || game.remaining != 10 {
+		t.Errorf("score, remaining = %d, %d, want no charge for a power-up without effect", game.score, game.remaining)
+	}
+	if got := game.Result().PowerUpsUsed[PowerUpVowels]; got != 1 {
+		t.Errorf("Result().PowerUpsUsed[vowels] = %d, want 1", got)
+	}
+}
@@ -0,0 +1,139 @@
+package hangman
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// SavedGame is a round in progress, written by the :save command so it can
+// be resumed from the menu later.
+type SavedGame struct {
+	Category      string          `json:"category"`
+	Word          string          `json:"word"`
+	Answer        []string        `json:"answer"`
+	Guessed       []string        `json:"guessed"`
+	Incorrect     []string        `json:"incorrect"`
+	Eliminated    []string        `json:"eliminated,omitempty"`
+	HintsUsed     []string        `json:"hints_used"`
+	NextHint      int             `json:"next_hint"`
+	Structural    []HintKind      `json:"structural,omitempty"`
+	PowerUpsUsed  map[PowerUp]int `json:"power_ups_used,omitempty"`
+	Score         int             `json:"score"`
+	Streak        int             `json:"streak"`
+	Remaining     int             `json:"remaining"`
+	GuessOrder    []string        `json:"guess_order"`
+	LongestStreak int             `json:"longest_streak"`
+	Events        []ScoreEvent    `json:"events"`
+	Elapsed       time.Duration   `json:"elapsed"`
+	SavedAt       time.Time       `json:"saved_at"`
+	// Marathon is set when the round is part of a marathon.
+	Marathon *SavedMarathon `json:"marathon,omitempty"`
+}
+
+// DefaultSaveFile returns the per-user save file, e.g. ~/.config/hangman/save.json.
+func DefaultSaveFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "hangman", "save.json"), nil
+}
+
+// Snapshot captures the state of the round.
+func (g *HangmanGame) Snapshot() SavedGame {
+	return SavedGame{
+		Category:      g.word.Category,
+		Word:          g.word.Text,
+		Answer:        slices.Clone(g.answer),
+		Guessed:       slices.Sorted(maps.Keys(g.guesses)),
+		Incorrect:     slices.Clone(g.incorrect),
+		Eliminated:    slices.Sorted(maps.Keys(g.eliminated)),
+		HintsUsed:     slices.Clone(g.hintsUsed),
+		NextHint:      g.nextHintIndex,
+		Structural:    slices.Sorted(maps.Keys(g.structural)),
+		PowerUpsUsed:  maps.Clone(g.powerUpsUsed),
+		Score:         g.score,
+		Streak:        g.streak,
+		Remaining:     g.remaining,
+		GuessOrder:    slices.Clone(g.guessOrder),
+		LongestStreak: g.longestStreak,
+		Events:        slices.Clone(g.events),
+		Elapsed:       g.elapsed(),
+		SavedAt:       time.Now(),
+	}
+}
+
+// RestoreGame recreates a saved round for word, which must be the saved word.
+func RestoreGame(word *Word, saved SavedGame, scoring Scoring) (*HangmanGame, error) {
+	game, err := NewHangmanGame(word, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(word.Text, saved.Word) || len(saved.Answer) != len(game.answer) {
+		return nil, fmt.Errorf("saved game does not match the word %q", word.Text)
+	}
+
+	game.SetScoring(scoring)
+	game.answer = slices.Clone(saved.Answer)
+	game.correctCount = 0
+	for _, letter := range game.answer {
+		if IsAlphabet(letter) && letter != "_" {
+			game.correctCount++
+		}
+	}
+	for _, letter := range saved.Guessed {
+		game.guesses[letter] = true
+	}
+	game.incorrect = slices.Clone(saved.Incorrect)
+	for _, letter := range saved.Eliminated {
+		game.eliminated[letter] = true
+	}
+	if len(saved.HintsUsed) > 0 {
+		game.hintsUsed = slices.Clone(saved.HintsUsed)
+		game.nextHintIndex = saved.NextHint
+	}
+	for _, kind := range saved.Structural {
+		game.structural[kind] = true
+	}
+	maps.Copy(game.powerUpsUsed, saved.PowerUpsUsed)
+	game.score, game.streak, game.remaining = saved.Score, saved.Streak, saved.Remaining
+	game.guessOrder = append(game.guessOrder, saved.GuessOrder...)
+	game.longestStreak = saved.LongestStreak
+	game.events = append(game.events, saved.Events...)
+	// The clock restarts where it stopped when the round was saved.
+	game.carried = saved.Elapsed
+	return game, nil
+}
+
+// WriteSavedGame writes a saved round to path, creating its directory.
+func WriteSavedGame(path string, saved SavedGame) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(saved, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, append(data, '\n'), 0o644)
+}
+
+// ReadSavedGame reads a saved round from path.
+func ReadSavedGame(path string) (*SavedGame, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var saved SavedGame
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &saved, nil
+}
@@ -0,0 +1,106 @@
+package hangman
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSavedGame_roundTrip(t *testing.T) {
+	word := &Word{Text: "Ice Cream", Hint: "Frozen dessert", ExtraHints: []string{"Served in a cone"}, Category: "Food"}
+	game, err := NewHangmanGame(word, 5)
+	if err != nil {
+		t.Fatalf("NewHangmanGame() unexpected error = %v", err)
+	}
+	game.SetScoring(Scoring{PointsPerCorrectGuess: 10, PowerUps: DefaultPowerUpRules()})
+	game.processGuess("c")
+	game.processGuess("z")
+	if _, err := game.requestHint(""); err != nil {
+		t.Fatalf("requestHint() unexpected error = %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "nested", "save.json")
+	if err := WriteSavedGame(path, game.Snapshot()); err != nil {
+		t.Fatalf("WriteSavedGame() unexpected error = %v", err)
+	}
+	saved, err := ReadSavedGame(path)
+	if err != nil {
+		t.Fatalf("ReadSavedGame() unexpected error = %v", err)
+	}
+
+	restored, err := RestoreGame(word, *saved, game.scoring)
+	if err != nil {
+		t.Fatalf("RestoreGame() unexpected error = %v", err)
+	}
+	if got, want := strings.Join(restored.answer, ""), strings.Join(game.answer, ""); got != want {
+		t.Errorf("answer = %q, want %q", got, want)
+	}
+	got, want := restored.Result(), game.Result()
+	if got.Duration < saved.Elapsed {
+		t.Errorf("Result().Duration = %v, want at least the saved %v", got.Duration, saved.Elapsed)
+	}
+	got.Duration, want.Duration = 0, 0
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Result() = %+v, want %+v", got, want)
+	}
+	if restored.correctCount != game.correctCount || restored.remaining != game.remaining || restored.streak != game.streak {
+		t.Errorf("restored counters = %d, %d, %d, want %d, %d, %d",
+			restored.correctCount, restored.remaining, restored.streak, game.correctCount, game.remaining, game.streak)
+	}
+	if !reflect.DeepEqual(restored.guesses, game.guesses) || !reflect.DeepEqual(restored.incorrect, game.incorrect) {
+		t.Errorf("guesses = %v %v, want %v %v", restored.guesses, restored.incorrect, game.guesses, game.incorrect)
+	}
+
+	if _, err := RestoreGame(&Word{Text: "Sorbet", Hint: "Frozen"}, *saved, game.scoring); err == nil {
+		t.Error("RestoreGame() with another word, want error")
+	}
+}
+
+func TestReadSavedGame_invalid(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := ReadSavedGame(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("ReadSavedGame() of a missing file, want error")
+	}
+	path := filepath.Join(dir, "save.json")
+	if err := os.WriteFile(path, []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadSavedGame(path); err == nil {
+		t.Error("ReadSavedGame() of invalid JSON, want error")
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	results := []RoundResult{
+		{State: GameStateWin, Score: 30, HintsUsed: []string{"a", "b"}},
+		{State: GameStateLose, Score: 5, HintsUsed: []string{"a"}},
+		{State: GameStateWin, Score: 10},
+		{State: GameStateAbandon, Score: 20},
+	}
+	want := SessionStats{Rounds: 4, Wins: 2, Losses: 1, Abandoned: 1, Score: 65, ExtraHints: 1}
+	if got := Summarize(results); got != want {
+		t.Errorf("Summarize() = %+v, want %+v", got, want)
+	}
+}
+
+func TestHangman_finishSavedRound(t *testing.T) {
+	h := &Hangman{scoring: DefaultScoring(), clock: SystemClock{}, saveFile: filepath.Join(t.TempDir(), "save.json")}
+	game, err := NewHangmanGame(&Word{Text: "Cat", Hint: "Kitty", Category: "Animals"}, 3)
+	if err != nil {
+		t.Fatalf("NewHangmanGame() unexpected error = %v", err)
+	}
+
+	if err := h.saveGame(game); err != nil {
+		t.Fatalf("saveGame() unexpected error = %v", err)
+	}
+	if !h.hasSavedGame() {
+		t.Fatal("hasSavedGame() = false after saveGame()")
+	}
+	game.solve("Cat")
+	h.finishRound(game)
+	if h.hasSavedGame() {
+		t.Error("hasSavedGame() = true after the saved round finished, want the save removed")
+	}
+}
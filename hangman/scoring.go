@@ -0,0 +1,178 @@
+package hangman
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultHintCostPoints is what an extra hint costs by default.
+const DefaultHintCostPoints = 5
+
+// Cost is what the player pays for help during a round.
+type Cost struct {
+	// Points are deducted from the round score, which never drops below zero.
+	Points int
+	// Lives are deducted from the remaining guesses.
+	Lives int
+}
+
+// String describes the cost, e.g. "5 points, 1 life", or "free".
+func (c Cost) String() string {
+	parts := []string{}
+	if c.Points > 0 {
+		parts = append(parts, plural(c.Points, "point"))
+	}
+	if c.Lives > 0 {
+		parts = append(parts, lives(c.Lives))
+	}
+	if len(parts) == 0 {
+		return "free"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Scoring decides how many points guesses earn and what help costs.
+type Scoring struct {
+	// PointsPerCorrectGuess is multiplied by the current streak for every correct letter.
+	PointsPerCorrectGuess int
+	// HintCost is charged for every hint after the first, free one.
+	HintCost Cost
+	// PowerUps limits and prices each power-up; missing entries are disabled.
+	PowerUps map[PowerUp]PowerUpRule
+	// TimeBonusPerSecond is earned for every second left when a timed round is won.
+	TimeBonusPerSecond int
+}
+
+// DefaultScoring returns the scoring used when no policy is configured.
+func DefaultScoring() Scoring {
+	return Scoring{
+		PointsPerCorrectGuess: PointsPerCorrectGuess,
+		HintCost:              Cost{Points: DefaultHintCostPoints},
+		PowerUps:              DefaultPowerUpRules(),
+		TimeBonusPerSecond:    DefaultTimeBonusPerSecond,
+	}
+}
+
+// RoundResult records how a round ended.
+type RoundResult struct {
+	Word  Word
+	State GameState
+	Score int
+	// HintsUsed lists the hints shown during the round, the free one first.
+	HintsUsed []string
+	// PowerUpsUsed counts the power-ups used during the round.
+	PowerUpsUsed map[PowerUp]int
+	// Guesses lists the letters guessed, in order.
+	Guesses []string
+	// Incorrect lists the letters guessed that are not in the word.
+	Incorrect     []string
+	LongestStreak int
+	Duration      time.Duration
+	// Events lists every change to the score, in order.
+	Events []ScoreEvent
+}
+
+// ScoreEvent is a change to the round score and what caused it.
+type ScoreEvent struct {
+	Reason string
+	// Points is negative for help paid with points.
+	Points int
+}
+
+// Summary formats the end-of-round screen: the answer, the hints shown, the
+// guesses and the points breakdown.
+func (r RoundResult) Summary() string {
+	builder := new(strings.Builder)
+	fmt.Fprintf(builder, "Answer: %s\n", r.Word.Text)
+	fmt.Fprintf(builder, "Hints: %s\n", strings.Join(r.HintsUsed, "; "))
+	fmt.Fprintf(builder, "Guessed: %s\n", listOrNone(r.Guesses))
+	fmt.Fprintf(builder, "Wrong: %s\n", listOrNone(r.Incorrect))
+	fmt.Fprintf(builder, "Longest streak: %d\n", r.LongestStreak)
+	fmt.Fprintf(builder, "Time: %s\n", r.Duration.Round(time.Second))
+	builder.WriteString("Points:\n")
+	for _, event := range r.Events {
+		fmt.Fprintf(builder, "  %+5d  %s\n", event.Points, event.Reason)
+	}
+	fmt.Fprintf(builder, "  %5d  total", r.Score)
+	return builder.String()
+}
+
+// listOrNone joins letters for the summary.
+func listOrNone(letters []string) string {
+	if len(letters) == 0 {
+		return "none"
+	}
+	return strings.Join(letters, ", ")
+}
+
+// SessionStats summarises the rounds played in a session.
+type SessionStats struct {
+	Rounds int
+	Wins   int
+	Losses int
+	// Abandoned counts the rounds forfeited with :menu or Ctrl-C.
+	Abandoned int
+	Score     int
+	// ExtraHints counts the hints requested beyond the free one.
+	ExtraHints int
+}
+
+// Summarize computes session statistics from round results.
+func Summarize(results []RoundResult) SessionStats {
+	var stats SessionStats
+	for _, result := range results {
+		stats.Rounds++
+		stats.Score += result.Score
+		stats.ExtraHints += max(0, len(result.HintsUsed)-1)
+		switch result.State {
+		case GameStateWin:
+			stats.Wins++
+		case GameStateLose:
+			stats.Losses++
+		case GameStateAbandon:
+			stats.Abandoned++
+		}
+	}
+	return stats
+}
+
+// String formats the statistics on one line.
+func (s SessionStats) String() string {
+	return fmt.Sprintf("rounds: %d, wins: %d, losses: %d, abandoned: %d, total score: %d, extra hints: %d",
+		s.Rounds, s.Wins, s.Losses, s.Abandoned, s.Score, s.ExtraHints)
+}
+
+// RunResult is the outcome of a run of consecutive words, such as a
+// time-attack or survival run.
+type RunResult struct {
+	Solved int
+	Rounds int
+	Score  int
+	// Average is the average time spent on a solved word.
+	Average time.Duration
+}
+
+// SummarizeRun computes the result of a run from its rounds.
+func SummarizeRun(results []RoundResult) RunResult {
+	var result RunResult
+	var solvedTime time.Duration
+	for _, round := range results {
+		result.Rounds++
+		result.Score += round.Score
+		if round.State == GameStateWin {
+			result.Solved++
+			solvedTime += round.Duration
+		}
+	}
+	if result.Solved > 0 {
+		result.Average = solvedTime / time.Duration(result.Solved)
+	}
+	return result
+}
+
+// String formats the result on one line.
+func (r RunResult) String() string {
+	return fmt.Sprintf("words solved: %d/%d, total score: %d, average time per word: %s",
+		r.Solved, r.Rounds, r.Score, r.Average.Round(time.Second))
+}
@@ -0,0 +1,260 @@
+package hangman
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// PackPolicy decides which word packs WordLoader is willing to serve.
+type PackPolicy struct {
+	// TrustedKeys verify pack signatures. A signed pack is only accepted if
+	// one of these keys produced the signature.
+	TrustedKeys []ed25519.PublicKey
+	// RequireSigned refuses packs without a valid signature and a checksum for every file.
+	RequireSigned bool
+}
+
+// Verify checks the manifest signature and the SHA-256 checksum of every
+// file against the supplied contents, as required by the policy.
+func (p PackPolicy) Verify(m *Manifest, files map[string][]byte) error {
+	if p.RequireSigned {
+		if m.Signature == "" {
+			return fmt.Errorf("pack %s is not signed", m.Name)
+		}
+		for _, name := range m.Files {
+			if _, ok := m.SHA256[name]; !ok {
+				return fmt.Errorf("pack %s has no checksum for %s", m.Name, name)
+			}
+		}
+	}
+
+	if m.Signature != "" && (p.RequireSigned || len(p.TrustedKeys) > 0) {
+		if err := p.verifySignature(m); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range m.Files {
+		want, ok := m.SHA256[name]
+		if !ok {
+			continue
+		}
+		if got := Checksum(files[name]); got != want {
+			return fmt.Errorf("pack %s: checksum mismatch for %s", m.Name, name)
+		}
+	}
+
+	return nil
+}
+
+// verifySignature checks the manifest signature against the trusted keys.
+func (p PackPolicy) verifySignature(m *Manifest) error {
+	if len(p.TrustedKeys) == 0 {
+		return fmt.Errorf("pack %s is signed but no trusted keys are configured", m.Name)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(m.Signature)
+	if err != nil {
+		return fmt.Errorf("pack %s has a malformed signature: %w", m.Name, err)
+	}
+
+	payload, err := m.SigningPayload()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range p.TrustedKeys {
+		if ed25519.Verify(key, payload, signature) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("pack %s: signature does not match any trusted key", m.Name)
+}
+
+// Checksum returns the hex-encoded SHA-256 digest of data.
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// SigningPayload returns the bytes covered by the manifest signature: the
+// manifest JSON, including checksums, with the signature left out.
+func (m *Manifest) SigningPayload() ([]byte, error) {
+	unsigned := *m
+	unsigned.Signature = ""
+	return json.Marshal(unsigned)
+}
+
+// readPack reads the manifest and every listed file of the pack directory at dir.
+func readPack(dir string) (*Manifest, map[string][]byte, error) {
+	fsys := os.DirFS(dir)
+	manifest, err := ReadManifest(fsys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	files := make(map[string][]byte, len(manifest.Files))
+	for _, name := range manifest.Files {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		files[name] = data
+	}
+	return manifest, files, nil
+}
+
+// SignPack records the checksum of every listed file in the manifest of the
+// pack directory at dir and signs it with key, rewriting pack.json in place.
+func SignPack(dir string, key ed25519.PrivateKey) (*Manifest, error) {
+	manifest, files, err := readPack(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest.SHA256 = make(map[string]string, len(files))
+	for name, data := range files {
+		manifest.SHA256[name] = Checksum(data)
+	}
+	manifest.Signature = ""
+
+	payload, err := manifest.SigningPayload()
+	if err != nil {
+		return nil, err
+	}
+	manifest.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(key, payload))
+
+	if err := writeManifest(dir, manifest); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// writeManifest rewrites pack.json in the pack directory at dir.
+func writeManifest(dir string, manifest *Manifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(dir, ManifestFile), append(data, '\n'), 0o644)
+}
+
+// VerifyPack checks the pack directory at dir against the policy.
+func VerifyPack(dir string, policy PackPolicy) (*Manifest, error) {
+	manifest, files, err := readPack(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, policy.Verify(manifest, files)
+}
+
+// GenerateSigningKey creates an ed25519 key pair and writes it as PEM files
+// at prefix+".key" (private, mode 0600) and prefix+".pub".
+func GenerateSigningKey(prefix string) error {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return err
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(prefix+".key", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}), 0o600); err != nil {
+		return err
+	}
+	return os.WriteFile(prefix+".pub", pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}), 0o644)
+}
+
+// ReadPrivateKey reads a PEM-encoded ed25519 private key.
+func ReadPrivateKey(path string) (ed25519.PrivateKey, error) {
+	der, err := readPEM(path, "PRIVATE KEY")
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	private, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s: not an ed25519 private key", path)
+	}
+	return private, nil
+}
+
+// ReadPublicKey reads a PEM-encoded ed25519 public key.
+func ReadPublicKey(path string) (ed25519.PublicKey, error) {
+	der, err := readPEM(path, "PUBLIC KEY")
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	public, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%s: not an ed25519 public key", path)
+	}
+	return public, nil
+}
+
+// readPEM reads the first PEM block of the given type from path.
+func readPEM(path, blockType string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != blockType {
+		return nil, fmt.Errorf("%s: expected a PEM %q block", path, blockType)
+	}
+	return block.Bytes, nil
+}
+
+// writeFileAtomic writes data to a temporary file next to path and renames it into place.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,193 @@
+package hangman
+
+import (
+	"crypto/ed25519"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writePackDir(t *testing.T, dir string) {
+	t.Helper()
+	for name, content := range testPackFiles() {
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(target, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testKeys(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey) {
+	t.Helper()
+	prefix := filepath.Join(t.TempDir(), "signer")
+	if err := GenerateSigningKey(prefix); err != nil {
+		t.Fatalf("GenerateSigningKey() unexpected error = %v", err)
+	}
+
+	private, err := ReadPrivateKey(prefix + ".key")
+	if err != nil {
+		t.Fatalf("ReadPrivateKey() unexpected error = %v", err)
+	}
+	public, err := ReadPublicKey(prefix + ".pub")
+	if err != nil {
+		t.Fatalf("ReadPublicKey() unexpected error = %v", err)
+	}
+	return public, private
+}
+
+func TestSignPack(t *testing.T) {
+	public, private := testKeys(t)
+	otherPublic, _ := testKeys(t)
+
+	dir := t.TempDir()
+	writePackDir(t, dir)
+
+	if _, err := VerifyPack(dir, PackPolicy{TrustedKeys: []ed25519.PublicKey{public}, RequireSigned: true}); err == nil {
+		t.Error("VerifyPack() expected error for unsigned pack when signatures are required")
+	}
+
+	manifest, err := SignPack(dir, private)
+	if err != nil {
+		t.Fatalf("SignPack() unexpected error = %v", err)
+	}
+	if len(manifest.SHA256) != 2 || manifest.Signature == "" {
+		t.Fatalf("SignPack() manifest = %+v, want two checksums and a signature", manifest)
+	}
+
+	tests := []struct {
+		name    string
+		policy  PackPolicy
+		tamper  func(t *testing.T)
+		wantErr bool
+	}{
+		{
+			name:   "trusted key",
+			policy: PackPolicy{TrustedKeys: []ed25519.PublicKey{public}, RequireSigned: true},
+		},
+		{
+			name:   "no keys and not required",
+			policy: PackPolicy{},
+		},
+		{
+			name:    "untrusted key",
+			policy:  PackPolicy{TrustedKeys: []ed25519.PublicKey{otherPublic}},
+			wantErr: true,
+		},
+		{
+			name:    "required without keys",
+			policy:  PackPolicy{RequireSigned: true},
+			wantErr: true,
+		},
+		{
+			name:   "tampered word file",
+			policy: PackPolicy{},
+			tamper: func(t *testing.T) {
+				if err := os.WriteFile(filepath.Join(dir, "birds.txt"), []byte("Birds\nDodo,Extinct\n"), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.tamper != nil {
+				tt.tamper(t)
+			}
+
+			_, err := VerifyPack(dir, tt.policy)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("VerifyPack() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWordLoader_LoadPackPolicy(t *testing.T) {
+	public, private := testKeys(t)
+
+	signed := filepath.Join(t.TempDir(), "birds")
+	writePackDir(t, signed)
+	if _, err := SignPack(signed, private); err != nil {
+		t.Fatalf("SignPack() unexpected error = %v", err)
+	}
+
+	unsigned := filepath.Join(t.TempDir(), "birds")
+	writePackDir(t, unsigned)
+
+	policy := PackPolicy{TrustedKeys: []ed25519.PublicKey{public}, RequireSigned: true}
+
+	loader := NewWordLoader()
+	loader.SetPackPolicy(policy)
+	if err := loader.LoadPack(signed); err != nil {
+		t.Fatalf("WordLoader.LoadPack() unexpected error = %v", err)
+	}
+	if len(loader.Categories()) != 2 {
+		t.Errorf("WordLoader.LoadPack() loaded %v categories, want 2", len(loader.Categories()))
+	}
+
+	loader = NewWordLoader()
+	loader.SetPackPolicy(policy)
+	if err := loader.LoadPack(unsigned); err == nil {
+		t.Error("WordLoader.LoadPack() expected error for unsigned pack")
+	}
+
+	loader.SetMode(LoadModeLenient)
+	if err := loader.LoadPack(unsigned); err != nil {
+		t.Errorf("WordLoader.LoadPack() lenient unexpected error = %v", err)
+	}
+	if len(loader.Categories()) != 0 {
+		t.Errorf("WordLoader.LoadPack() served %v from an unsigned pack", loader.Categories())
+	}
+}
+
+func TestWordLoader_LoadPackUntrustedSignature(t *testing.T) {
+	_, private := testKeys(t)
+	signed := filepath.Join(t.TempDir(), "birds")
+	writePackDir(t, signed)
+	if _, err := SignPack(signed, private); err != nil {
+		t.Fatalf("SignPack() unexpected error = %v", err)
+	}
+
+	loader := NewWordLoader()
+	if err := loader.LoadPack(signed); err != nil {
+		t.Fatalf("WordLoader.LoadPack() unexpected error = %v", err)
+	}
+	if len(loader.Categories()) != 2 || len(loader.Diagnostics()) != 1 {
+		t.Errorf("WordLoader.LoadPack() = %v, %v, want the pack with an unchecked signature diagnostic",
+			loader.Categories(), loader.Diagnostics())
+	}
+}
+
+func TestNewHangman_RequireSigned(t *testing.T) {
+	public, private := testKeys(t)
+	policy := PackPolicy{TrustedKeys: []ed25519.PublicKey{public}, RequireSigned: true}
+
+	unsigned := DefaultConfig()
+	unsigned.DataDir = "testdata/groups"
+	unsigned.PackPolicy = policy
+	if _, err := NewHangman(unsigned); err == nil {
+		t.Error("NewHangman() served an unsigned data directory, want no categories error")
+	}
+
+	signed := filepath.Join(t.TempDir(), "birds")
+	writePackDir(t, signed)
+	if _, err := SignPack(signed, private); err != nil {
+		t.Fatalf("SignPack() unexpected error = %v", err)
+	}
+	cfg := DefaultConfig()
+	cfg.DataDir = signed
+	cfg.PackPolicy = policy
+	if _, err := NewHangman(cfg); err != nil {
+		t.Errorf("NewHangman() with a signed data directory unexpected error = %v", err)
+	}
+
+	cfg.Dictionaries = []Dictionary{{Path: "testdata/dictionary.txt"}}
+	if _, err := NewHangman(cfg); err == nil {
+		t.Error("NewHangman() with a dictionary source, want error when signed packs are required")
+	}
+}
@@ -0,0 +1,189 @@
+package hangman
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+)
+
+// WordSource provides categories of words. WordLoader implements it for
+// directories and single files; other backends are in-memory lists, SQL
+// databases and HTTP endpoints.
+type WordSource interface {
+	// Categories returns the available category titles.
+	Categories() []string
+	// GetWords returns every word in the category.
+	GetWords(category string) ([]Word, error)
+	// RandomWord returns a random word from the category.
+	RandomWord(category string) (*Word, error)
+}
+
+var (
+	_ WordSource = (*WordLoader)(nil)
+	_ WordSource = (*MemorySource)(nil)
+	_ WordSource = (*MultiSource)(nil)
+	_ WordSource = (*SQLSource)(nil)
+	_ WordSource = (*HTTPSource)(nil)
+)
+
+// NewDirSource loads every word file below dir.
+func NewDirSource(dir string) (*WordLoader, error) {
+	loader := NewWordLoader()
+	if err := loader.Load(dir); err != nil {
+		return nil, err
+	}
+	return loader, nil
+}
+
+// NewFileSource loads a single word file.
+func NewFileSource(path string) (*WordLoader, error) {
+	return NewDirSource(path)
+}
+
+// randomWord picks a random word from a source's category.
+func randomWord(src WordSource, category string) (*Word, error) {
+	words, err := src.GetWords(category)
+	if err != nil {
+		return nil, err
+	}
+
+	index := rand.Intn(len(words))
+	return &words[index], nil
+}
+
+// MemorySource is a WordSource backed by in-memory word lists.
+type MemorySource struct {
+	categories    []string
+	categoryWords map[string][]Word
+}
+
+// NewMemorySource creates an empty MemorySource.
+func NewMemorySource() *MemorySource {
+	return &MemorySource{
+		categories:    []string{},
+		categoryWords: make(map[string][]Word),
+	}
+}
+
+// Add appends words to a category, creating it if needed.
+func (s *MemorySource) Add(category string, words ...Word) {
+	if _, ok := s.categoryWords[category]; !ok {
+		s.categories = append(s.categories, category)
+	}
+
+	for _, word := range words {
+		word.Category = category
+		s.categoryWords[category] = append(s.categoryWords[category], word)
+	}
+}
+
+// Categories returns the categories in the order they were added.
+func (s *MemorySource) Categories() []string {
+	return slices.Clone(s.categories)
+}
+
+// GetWords retrieves words for a given category.
+func (s *MemorySource) GetWords(category string) ([]Word, error) {
+	words, ok := s.categoryWords[category]
+	if !ok {
+		return nil, errors.New("category not found")
+	}
+
+	if len(words) == 0 {
+		return nil, errors.New("no words available in this category")
+	}
+
+	return words, nil
+}
+
+// RandomWord retrieves a random word from the specified category.
+func (s *MemorySource) RandomWord(category string) (*Word, error) {
+	return randomWord(s, category)
+}
+
+// MultiSource merges several sources into one catalog. Categories with the
+// same title in different sources are combined.
+type MultiSource struct {
+	sources []WordSource
+}
+
+// NewMultiSource creates a catalog over the given sources, listed in priority order.
+func NewMultiSource(sources ...WordSource) *MultiSource {
+	return &MultiSource{sources: sources}
+}
+
+// Categories returns the union of all categories, in source order.
+func (s *MultiSource) Categories() []string {
+	categories := []string{}
+	for _, src := range s.sources {
+		for _, category := range src.Categories() {
+			if !slices.Contains(categories, category) {
+				categories = append(categories, category)
+			}
+		}
+	}
+	return categories
+}
+
+// GetWords returns the words of the category from every source that has it.
+func (s *MultiSource) GetWords(category string) ([]Word, error) {
+	words := []Word{}
+	for _, src := range s.sources {
+		if !slices.Contains(src.Categories(), category) {
+			continue
+		}
+
+		found, err := src.GetWords(category)
+		if err != nil {
+			return nil, err
+		}
+		words = append(words, found...)
+	}
+
+	if len(words) == 0 {
+		return nil, errors.New("category not found")
+	}
+
+	return words, nil
+}
+
+// RandomWord retrieves a random word from the merged category.
+func (s *MultiSource) RandomWord(category string) (*Word, error) {
+	return randomWord(s, category)
+}
+
+// LoadSource copies every category of src into the loader's root group, so
+// databases and remote catalogs appear in the menu next to word files. Invalid
+// words are reported as diagnostics and skipped in lenient mode.
+func (l *WordLoader) LoadSource(src WordSource) error {
+	for _, category := range src.Categories() {
+		words, err := src.GetWords(category)
+		if err != nil {
+			return err
+		}
+
+		// Words are checked against the same rules as word file lines.
+		valid := make([]Word, 0, len(words))
+		for _, word := range words {
+			if reason := cleanWord(&word); reason != "" {
+				diag := l.report(Diagnostic{Path: word.Source.Path, Line: word.Source.Line, Reason: reason})
+				if l.mode != LoadModeLenient {
+					return diag
+				}
+				continue
+			}
+			word.meta = computeMeta(word.Text)
+			valid = append(valid, word)
+		}
+		if len(valid) == 0 {
+			diag := l.report(Diagnostic{Path: category, Reason: "category has no valid words"})
+			if l.mode != LoadModeLenient {
+				return diag
+			}
+			continue
+		}
+
+		l.addCategory(RootGroup, &wordFile{category: category, words: valid})
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package hangman
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// HTTPSource is a WordSource backed by a remote catalog exposing
+//
+//	GET {base}/categories                -> ["Animals", ...]
+//	GET {base}/categories/{title}/words  -> [{"text": "Cat", "hint": "Kitty"}, ...]
+//
+// as served by NewHTTPHandler.
+type HTTPSource struct {
+	client     *http.Client
+	base       string
+	categories []string
+}
+
+// httpWord is the JSON representation of a word served over HTTP.
+type httpWord struct {
+	Text       string   `json:"text"`
+	Aliases    []string `json:"aliases,omitempty"`
+	Hint       string   `json:"hint"`
+	ExtraHints []string `json:"extra_hints,omitempty"`
+}
+
+// DefaultHTTPTimeout bounds every request of an HTTPSource created without a
+// client, so a stalled catalog cannot hang startup.
+const DefaultHTTPTimeout = 10 * time.Second
+
+// NewHTTPSource fetches the category list from the catalog at base.
+// A nil client uses a client with DefaultHTTPTimeout.
+func NewHTTPSource(client *http.Client, base string) (*HTTPSource, error) {
+	if client == nil {
+		client = &http.Client{Timeout: DefaultHTTPTimeout}
+	}
+
+	s := &HTTPSource{client: client, base: strings.TrimSuffix(base, "/")}
+	if err := s.get("/categories", &s.categories); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Categories returns the categories present when the source was created.
+func (s *HTTPSource) Categories() []string {
+	return s.categories
+}
+
+// GetWords fetches the words of a category.
+func (s *HTTPSource) GetWords(category string) ([]Word, error) {
+	var remote []httpWord
+	if err := s.get("/categories/"+url.PathEscape(category)+"/words", &remote); err != nil {
+		return nil, err
+	}
+
+	if len(remote) == 0 {
+		return nil, errors.New("no words available in this category")
+	}
+
+	words := make([]Word, 0, len(remote))
+	for i, w := range remote {
+		words = append(words, Word{
+			Text:       w.Text,
+			Aliases:    w.Aliases,
+			Hint:       w.Hint,
+			ExtraHints: w.ExtraHints,
+			Category:   category,
+			Source:     Source{Path: s.base, Line: i + 1},
+		})
+	}
+	return words, nil
+}
+
+// RandomWord retrieves a random word from the specified category.
+func (s *HTTPSource) RandomWord(category string) (*Word, error) {
+	return randomWord(s, category)
+}
+
+// get decodes the JSON response of a GET request to base+path into v.
+func (s *HTTPSource) get(path string, v any) error {
+	resp, err := s.client.Get(s.base + path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errors.New("category not found")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s%s: %s", s.base, path, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// NewHTTPHandler serves src using the protocol read by HTTPSource.
+func NewHTTPHandler(src WordSource) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /categories", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, src.Categories())
+	})
+	mux.HandleFunc("GET /categories/{category}/words", func(w http.ResponseWriter, r *http.Request) {
+		words, err := src.GetWords(r.PathValue("category"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		remote := make([]httpWord, 0, len(words))
+		for _, word := range words {
+			remote = append(remote, httpWord{Text: word.Text, Aliases: word.Aliases, Hint: word.Hint, ExtraHints: word.ExtraHints})
+		}
+		writeJSON(w, remote)
+	})
+	return mux
+}
+
+// writeJSON writes v as a JSON response.
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,97 @@
+package hangman
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SQLSchema creates the table read by SQLSource. Like word files, text may
+// list aliases after the canonical word separated by AliasSeparator, and hint
+// may list progressive hints separated by HintSeparator.
+const SQLSchema = `CREATE TABLE IF NOT EXISTS words (
+	category TEXT NOT NULL,
+	text     TEXT NOT NULL,
+	hint     TEXT NOT NULL DEFAULT ''
+)`
+
+// SQLSource is a WordSource backed by the "words" table of a SQL database
+// such as SQLite. The caller opens the database with the driver of its choice.
+type SQLSource struct {
+	db         *sql.DB
+	name       string
+	categories []string
+}
+
+// NewSQLSource reads the category list from db. name identifies the database
+// in word sources, e.g. the SQLite file path.
+func NewSQLSource(db *sql.DB, name string) (*SQLSource, error) {
+	rows, err := db.Query(`SELECT DISTINCT category FROM words ORDER BY category`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	defer rows.Close()
+
+	categories := []string{}
+	for rows.Next() {
+		var category string
+		if err := rows.Scan(&category); err != nil {
+			return nil, err
+		}
+		categories = append(categories, category)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &SQLSource{db: db, name: name, categories: categories}, nil
+}
+
+// Categories returns the categories present when the source was created.
+func (s *SQLSource) Categories() []string {
+	return s.categories
+}
+
+// GetWords queries the words of a category.
+func (s *SQLSource) GetWords(category string) ([]Word, error) {
+	rows, err := s.db.Query(`SELECT rowid, text, hint FROM words WHERE category = ? ORDER BY rowid`, category)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query words: %w", err)
+	}
+	defer rows.Close()
+
+	words := []Word{}
+	for rows.Next() {
+		var (
+			rowID int
+			word  = Word{Category: category}
+		)
+		var text, hint string
+		if err := rows.Scan(&rowID, &text, &hint); err != nil {
+			return nil, err
+		}
+		word.Text, word.Aliases, _ = splitAliases(text)
+		hints := strings.Split(hint, HintSeparator)
+		word.Hint = hints[0]
+		if len(hints) > 1 {
+			word.ExtraHints = hints[1:]
+		}
+		word.Source = Source{Path: s.name, Line: rowID}
+		words = append(words, word)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(words) == 0 {
+		return nil, errors.New("category not found")
+	}
+
+	return words, nil
+}
+
+// RandomWord retrieves a random word from the specified category.
+func (s *SQLSource) RandomWord(category string) (*Word, error) {
+	return randomWord(s, category)
+}
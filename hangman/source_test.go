@@ -0,0 +1,195 @@
+package hangman
+
+import (
+	"database/sql"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	_ "modernc.org/sqlite"
+)
+
+func testMemorySource() *MemorySource {
+	src := NewMemorySource()
+	src.Add("Animals", Word{Text: "Cat", Hint: "Kitty"}, Word{Text: "Dog", Hint: "Puppy"})
+	src.Add("Fruits", Word{Text: "Apple", Hint: "Keeps the doctor away"})
+	return src
+}
+
+func TestWordSources(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "words.db")
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatalf("sql.Open() unexpected error = %v", err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(SQLSchema); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO words (category, text, hint) VALUES
+		('Animals', 'Cat', 'Kitty'), ('Animals', 'Dog', 'Puppy'), ('Fruits', 'Apple', 'Keeps the doctor away')`); err != nil {
+		t.Fatalf("insert words: %v", err)
+	}
+
+	sqlSource, err := NewSQLSource(db, dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLSource() unexpected error = %v", err)
+	}
+
+	server := httptest.NewServer(NewHTTPHandler(testMemorySource()))
+	defer server.Close()
+
+	httpSource, err := NewHTTPSource(server.Client(), server.URL)
+	if err != nil {
+		t.Fatalf("NewHTTPSource() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		src  WordSource
+	}{
+		{"memory", testMemorySource()},
+		{"sql", sqlSource},
+		{"http", httpSource},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if categories := tt.src.Categories(); !reflect.DeepEqual(categories, []string{"Animals", "Fruits"}) {
+				t.Errorf("Categories() = %v, want [Animals Fruits]", categories)
+			}
+
+			words, err := tt.src.GetWords("Animals")
+			if err != nil {
+				t.Fatalf("GetWords() unexpected error = %v", err)
+			}
+			if len(words) != 2 || words[0].Text != "Cat" || words[0].Hint != "Kitty" || words[0].Category != "Animals" {
+				t.Errorf("GetWords() = %+v, want Cat and Dog in Animals", words)
+			}
+
+			word, err := tt.src.RandomWord("Fruits")
+			if err != nil {
+				t.Fatalf("RandomWord() unexpected error = %v", err)
+			}
+			if word.Text != "Apple" {
+				t.Errorf("RandomWord() = %v, want Apple", word.Text)
+			}
+
+			if _, err := tt.src.GetWords("Missing"); err == nil {
+				t.Error("GetWords() expected error for unknown category")
+			}
+		})
+	}
+}
+
+func TestMultiSource(t *testing.T) {
+	files, err := NewDirSource("testdata/valid_data")
+	if err != nil {
+		t.Fatalf("NewDirSource() unexpected error = %v", err)
+	}
+
+	memory := NewMemorySource()
+	memory.Add("Fruits", Word{Text: "Kiwi", Hint: "Green and fuzzy"})
+	memory.Add("Animals", Word{Text: "Cat", Hint: "Kitty"})
+
+	catalog := NewMultiSource(files, memory)
+
+	expected := []string{"Colors", "Fruits", "Animals"}
+	if !reflect.DeepEqual(catalog.Categories(), expected) {
+		t.Errorf("MultiSource.Categories() = %v, want %v", catalog.Categories(), expected)
+	}
+
+	words, err := catalog.GetWords("Fruits")
+	if err != nil {
+		t.Fatalf("MultiSource.GetWords() unexpected error = %v", err)
+	}
+	if len(words) != 4 || words[3].Text != "Kiwi" {
+		t.Errorf("MultiSource.GetWords() = %v, want the three file fruits then Kiwi", words)
+	}
+
+	if _, err := catalog.RandomWord("Missing"); err == nil {
+		t.Error("MultiSource.RandomWord() expected error for unknown category")
+	}
+}
+
+func TestNewFileSource(t *testing.T) {
+	src, err := NewFileSource("testdata/fruits.txt")
+	if err != nil {
+		t.Fatalf("NewFileSource() unexpected error = %v", err)
+	}
+	if !reflect.DeepEqual(src.Categories(), []string{"Fruits"}) {
+		t.Errorf("NewFileSource() categories = %v, want [Fruits]", src.Categories())
+	}
+}
+
+func TestWordLoader_LoadSource(t *testing.T) {
+	loader := NewWordLoader()
+	if err := loader.Load("testdata/fruits.txt"); err != nil {
+		t.Fatalf("WordLoader.Load() unexpected error = %v", err)
+	}
+	if err := loader.LoadSource(testMemorySource()); err != nil {
+		t.Fatalf("WordLoader.LoadSource() unexpected error = %v", err)
+	}
+
+	if !reflect.DeepEqual(loader.Categories(), []string{"Fruits", "Animals"}) {
+		t.Errorf("WordLoader.Categories() = %v, want [Fruits Animals]", loader.Categories())
+	}
+
+	words, err := loader.GetWords("Fruits")
+	if err != nil {
+		t.Fatalf("WordLoader.GetWords() unexpected error = %v", err)
+	}
+	if len(words) != 4 {
+		t.Errorf("WordLoader.GetWords() returned %v words, want 4", len(words))
+	}
+}
+
+func TestWordLoader_LoadSourceInvalid(t *testing.T) {
+	src := NewMemorySource()
+	src.Add("Animals",
+		Word{Text: " Cat ", Hint: " Kitty "},
+		Word{Text: " ", Hint: "Nothing"},
+		Word{Text: "Owl", Hint: "", ExtraHints: []string{"Hoots at night"}},
+	)
+	src.Add("Empty", Word{Text: "", Hint: "Nothing"})
+
+	tests := []struct {
+		name      string
+		mode      LoadMode
+		wantErr   bool
+		wantDiags int
+	}{
+		{name: "strict", mode: LoadModeStrict, wantErr: true, wantDiags: 1},
+		{name: "lenient", mode: LoadModeLenient, wantDiags: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := NewWordLoader()
+			loader.SetMode(tt.mode)
+			err := loader.LoadSource(src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WordLoader.LoadSource() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(loader.Diagnostics()) != tt.wantDiags {
+				t.Errorf("WordLoader.Diagnostics() = %v, want %d entries", loader.Diagnostics(), tt.wantDiags)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if !reflect.DeepEqual(loader.Categories(), []string{"Animals"}) {
+				t.Errorf("WordLoader.Categories() = %v, want [Animals]", loader.Categories())
+			}
+			words, err := loader.GetWords("Animals")
+			if err != nil {
+				t.Fatalf("WordLoader.GetWords() unexpected error = %v", err)
+			}
+			if len(words) != 1 || words[0].Text != "Cat" || words[0].Hint != "Kitty" {
+				t.Errorf("WordLoader.GetWords() = %v, want only the trimmed Cat", words)
+			}
+		})
+	}
+}
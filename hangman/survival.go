@@ -0,0 +1,171 @@
+package hangman
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultSurvivalRefill is how many lives a perfect solve gives back.
+	DefaultSurvivalRefill = 2
+	// DefaultSurvivalRampEvery is how many words must be solved before the
+	// words drawn get harder.
+	DefaultSurvivalRampEvery = 2
+)
+
+// Survival shares a single pool of lives across consecutive words.
+type Survival struct {
+	// Lives is the pool a run starts with; zero plays classic rounds.
+	Lives int
+	// Refill is how many lives a perfect solve, one without a wrong guess,
+	// gives back, up to Lives.
+	Refill int
+	// RampEvery is how many words must be solved before the difficulty of
+	// the words drawn goes up by one.
+	RampEvery int
+	// Categories are the categories words are drawn from; when empty the
+	// first run asks for a category or group in the menu.
+	Categories []string
+}
+
+// survivalRun is a survival run in progress.
+type survivalRun struct {
+	lives      int
+	solved     int
+	words      []Word
+	used       map[int]bool
+	categories []string
+	// first is the index of the run's first round in Hangman.results.
+	first int
+}
+
+// SetLives sets the wrong guesses left in the round, e.g. a pool carried
+// over from earlier words.
+func (g *HangmanGame) SetLives(lives int) {
+	g.remaining = lives
+}
+
+// startSurvival starts a run drawing from words.
+func (h *Hangman) startSurvival(words []Word, categories []string) {
+	h.survivalRun = &survivalRun{
+		lives:      h.survival.Lives,
+		words:      words,
+		used:       make(map[int]bool),
+		categories: categories,
+		first:      len(h.results),
+	}
+	logrus.Infof("❤️ Survival: %s for as many words as you can solve", lives(h.survival.Lives))
+}
+
+// nextSurvivalRound draws the next word of the run, or ends the run when no
+// lives are left.
+func (h *Hangman) nextSurvivalRound() (*HangmanGame, GameState, error) {
+	run := h.survivalRun
+	if run.lives <= 0 {
+		h.finishSurvival(runOver)
+		return nil, GameStatePending, nil
+	}
+
+	target := run.difficulty(h.survival.RampEvery)
+	word := run.draw(target)
+	h.recordPlay(word.Category)
+
+	game, err := NewHangmanGame(word, 0)
+	if err != nil {
+		return nil, GameStateQuit, fmt.Errorf("failed to create game: %w", err)
+	}
+	game.SetScoring(h.scoring)
+	h.setupGame(game)
+	game.SetLives(run.lives)
+
+	logrus.Infof("❤️ Word %d, difficulty %d, %s left", len(h.results)-run.first+1, word.Meta().Difficulty, lives(run.lives))
+	return game, GameStatePlaying, nil
+}
+
+// endSurvivalRound carries the lives left in a round over to the run.
+// Forfeiting a word ends the run.
+func (h *Hangman) endSurvivalRound(result RoundResult, left int) {
+	run := h.survivalRun
+	run.lives = left
+	switch result.State {
+	case GameStateAbandon:
+		run.lives = 0
+	case GameStateWin:
+		run.solved++
+		if len(result.Incorrect) == 0 && h.survival.Refill > 0 && run.lives < h.survival.Lives {
+			refill := min(h.survival.Refill, h.survival.Lives-run.lives)
+			run.lives += refill
+			logrus.Infof("❤️ Perfect solve, %s back", lives(refill))
+		}
+	}
+}
+
+// finishSurvival shows the result of the run and ranks it.
+func (h *Hangman) finishSurvival(end runEnd) {
+	run := h.survivalRun
+	result := SummarizeRun(h.results[run.first:])
+	if end == runStopped {
+		logrus.Info("❤️ Run ended! ", result)
+	} else {
+		logrus.Info("💀 Out of lives! ", result)
+	}
+	h.recordScore(ModeSurvival, LeaderboardEntry{
+		Score:      result.Score,
+		Solved:     result.Solved,
+		Rounds:     result.Rounds,
+		Average:    result.Average,
+		Categories: run.categories,
+		PlayedAt:   h.clock.Now(),
+	})
+	h.survivalRun = nil
+}
+
+// difficulty returns the difficulty the next word should have: the easiest
+// of the run's words at first, one more every rampEvery words solved.
+func (r *survivalRun) difficulty(rampEvery int) int {
+	easiest := MaxDifficulty
+	for i := range r.words {
+		easiest = min(easiest, r.words[i].Meta().Difficulty)
+	}
+	if rampEvery <= 0 {
+		return easiest
+	}
+	return min(MaxDifficulty, easiest+r.solved/rampEvery)
+}
+
+// draw picks a word not played yet in the run whose difficulty is the
+// closest to target. Words are played again once every word was used.
+func (r *survivalRun) draw(target int) *Word {
+	if len(r.used) == len(r.words) {
+		clear(r.used)
+	}
+
+	candidates := []int{}
+	closest := MaxDifficulty
+	for i := range r.words {
+		if r.used[i] {
+			continue
+		}
+		distance := abs(r.words[i].Meta().Difficulty - target)
+		if distance < closest {
+			candidates, closest = candidates[:0], distance
+		}
+		if distance == closest {
+			candidates = append(candidates, i)
+		}
+	}
+
+	i := candidates[rand.Intn(len(candidates))]
+	r.used[i] = true
+	return &r.words[i]
+}
+
+// abs returns the absolute value of n.
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
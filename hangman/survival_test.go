@@ -0,0 +1,127 @@
+package hangman
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// survivalWords have the difficulties 4, 5, 7 and 10.
+var survivalWords = []Word{{Text: "Reason", Hint: "Why"}, {Text: "Stone", Hint: "Rock"}, {Text: "Eat", Hint: "Dine"}, {Text: "Jazz", Hint: "Music"}}
+
+func TestSurvivalRun_draw(t *testing.T) {
+	tests := []struct {
+		name           string
+		solved         int
+		rampEvery      int
+		wantDifficulty int
+		wantWord       string
+	}{
+		{"starts with the easiest", 0, 2, 4, "Reason"},
+		{"ramps up", 3, 2, 5, "Stone"},
+		{"closest word", 8, 2, 8, "Eat"},
+		{"capped", 40, 2, MaxDifficulty, "Jazz"},
+		{"no ramp", 40, 0, 4, "Reason"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			run := &survivalRun{words: survivalWords, used: make(map[int]bool), solved: tt.solved}
+			target := run.difficulty(tt.rampEvery)
+			if target != tt.wantDifficulty {
+				t.Errorf("difficulty() = %d, want %d", target, tt.wantDifficulty)
+			}
+			if got := run.draw(target).Text; got != tt.wantWord {
+				t.Errorf("draw() = %q, want %q", got, tt.wantWord)
+			}
+
+			for range len(survivalWords) - 1 {
+				if run.draw(target).Text == tt.wantWord {
+					t.Errorf("draw() repeated %q before every word was played", tt.wantWord)
+				}
+			}
+			if run.draw(target); len(run.used) != 1 {
+				t.Errorf("used = %v, want the words played again once all were used", run.used)
+			}
+		})
+	}
+}
+
+func TestHangman_survival(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	leaderboard := filepath.Join(t.TempDir(), "leaderboard.json")
+	h := &Hangman{
+		WordLoader:      NewWordLoader(),
+		scoring:         DefaultScoring(),
+		clock:           clock,
+		survival:        Survival{Lives: 5, Refill: 2, RampEvery: 2},
+		leaderboardFile: leaderboard,
+	}
+	h.startSurvival(survivalWords, []string{"Words"})
+
+	play := func(guesses string, wantLives int) *HangmanGame {
+		t.Helper()
+		game, state, err := h.nextSurvivalRound()
+		if err != nil || state != GameStatePlaying {
+			t.Fatalf("nextSurvivalRound() = %v, %q, want a round", err, state)
+		}
+		if game.remaining != wantLives {
+			t.Errorf("remaining = %d, want the %d lives of the pool", game.remaining, wantLives)
+		}
+		game.guessLetters(guesses)
+		game.state = GameStateLose
+		if game.isWin() {
+			game.state = GameStateWin
+		}
+		h.finishRound(game)
+		return game
+	}
+
+	// Reason: two wrong guesses, then solved.
+	play("xyreason", 5)
+	// Stone: a perfect solve refills the two lives lost.
+	play("stone", 3)
+	// The third word is lost, which empties the pool.
+	play("qwvkbfghlmp", 5)
+
+	if _, state, err := h.nextSurvivalRound(); err != nil || state != GameStatePending {
+		t.Fatalf("nextSurvivalRound() out of lives = %v, %q, want the menu", err, state)
+	}
+	if h.survivalRun != nil {
+		t.Error("survival run still going without lives")
+	}
+
+	board, err := ReadLeaderboard(leaderboard)
+	if err != nil {
+		t.Fatalf("ReadLeaderboard() unexpected error = %v", err)
+	}
+	if top := board.Top(ModeSurvival); len(top) != 1 || top[0].Solved != 2 || top[0].Rounds != 3 {
+		t.Errorf("survival leaderboard = %+v, want the run with 2 of 3 words solved", top)
+	}
+}
+
+func TestHangman_endSurvivalRound(t *testing.T) {
+	tests := []struct {
+		name      string
+		result    RoundResult
+		lives     int
+		wantLives int
+	}{
+		{"lives carry over", RoundResult{State: GameStateWin, Incorrect: []string{"x"}}, 3, 3},
+		{"perfect solve refills", RoundResult{State: GameStateWin}, 1, 3},
+		{"refill capped at the pool", RoundResult{State: GameStateWin}, 4, 5},
+		{"loss", RoundResult{State: GameStateLose, Incorrect: []string{"x"}}, 0, 0},
+		{"forfeit ends the run", RoundResult{State: GameStateAbandon}, 4, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Hangman{survival: Survival{Lives: 5, Refill: 2}}
+			h.survivalRun = &survivalRun{lives: 5}
+			h.endSurvivalRound(tt.result, tt.lives)
+			if h.survivalRun.lives != tt.wantLives {
+				t.Errorf("lives = %d, want %d", h.survivalRun.lives, tt.wantLives)
+			}
+		})
+	}
+}
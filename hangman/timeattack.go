@@ -0,0 +1,144 @@
+package hangman
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// TimeAttack plays as many words as possible before a global timer runs out.
+type TimeAttack struct {
+	// Duration is the length of a run; zero plays classic rounds.
+	Duration time.Duration
+	// Categories are the categories words are drawn from; when empty the
+	// first run asks for a category or group in the menu.
+	Categories []string
+}
+
+// attackRun is a time-attack run in progress.
+type attackRun struct {
+	ends       time.Time
+	words      []Word
+	next       int
+	categories []string
+	// first is the index of the run's first round in Hangman.results.
+	first int
+}
+
+// categoryWords returns the words of every category, for runs started
+// without the menu.
+func (h *Hangman) categoryWords(categories []string) ([]Word, error) {
+	words := []Word{}
+	for _, category := range categories {
+		categoryWords, err := h.WordLoader.GetWords(category)
+		if err != nil {
+			return nil, err
+		}
+		words = append(words, categoryWords...)
+	}
+	if len(words) == 0 {
+		return nil, errors.New("no categories chosen for the run")
+	}
+	return words, nil
+}
+
+// startTimeAttack starts the global timer of a run drawing from words.
+func (h *Hangman) startTimeAttack(words []Word, categories []string) {
+	words = slices.Clone(words)
+	rand.Shuffle(len(words), func(i, j int) { words[i], words[j] = words[j], words[i] })
+	h.attack = &attackRun{
+		ends:       h.clock.Now().Add(h.timeAttack.Duration),
+		words:      words,
+		categories: categories,
+		first:      len(h.results),
+	}
+	logrus.Infof("⏱ Time attack: solve as many words as you can in %s", h.timeAttack.Duration)
+}
+
+// draw returns the next word of the run. Words are drawn without replacement;
+// once every word was played they are shuffled again, keeping the last word
+// from coming up twice in a row.
+func (run *attackRun) draw() *Word {
+	if run.next == len(run.words) {
+		last := run.words[len(run.words)-1]
+		rand.Shuffle(len(run.words), func(i, j int) { run.words[i], run.words[j] = run.words[j], run.words[i] })
+		if len(run.words) > 1 && run.words[0].Text == last.Text {
+			run.words[0], run.words[len(run.words)-1] = run.words[len(run.words)-1], run.words[0]
+		}
+		run.next = 0
+	}
+	run.next++
+	return &run.words[run.next-1]
+}
+
+// nextAttackRound draws the next word of the run, or ends the run when the
+// global timer has run out.
+func (h *Hangman) nextAttackRound() (*HangmanGame, GameState, error) {
+	left := h.attack.ends.Sub(h.clock.Now())
+	if left <= 0 {
+		h.finishTimeAttack(runOver)
+		return nil, GameStatePending, nil
+	}
+
+	word := h.attack.draw()
+	h.recordPlay(word.Category)
+
+	game, err := NewHangmanGame(word, h.additionalMaxGuesses)
+	if err != nil {
+		return nil, GameStateQuit, fmt.Errorf("failed to create game: %w", err)
+	}
+	// The seconds left belong to the run, not to the word.
+	scoring := h.scoring
+	scoring.TimeBonusPerSecond = 0
+	game.SetScoring(scoring)
+	h.setupGame(game)
+	game.SetTiming(Timing{PerGuess: h.timing.PerGuess, PerRound: left})
+
+	logrus.Infof("⏱ Word %d, %s left", len(h.results)-h.attack.first+1, left.Round(time.Second))
+	return game, GameStatePlaying, nil
+}
+
+// finishTimeAttack shows the result of the run and ranks it.
+func (h *Hangman) finishTimeAttack(end runEnd) {
+	result := SummarizeRun(h.results[h.attack.first:])
+	if end == runStopped {
+		logrus.Info("⏱ Run ended! ", result)
+	} else {
+		logrus.Info("⏱ Time is up! ", result)
+	}
+	h.recordScore(ModeTimeAttack, LeaderboardEntry{
+		Score:      result.Score,
+		Solved:     result.Solved,
+		Rounds:     result.Rounds,
+		Average:    result.Average,
+		Categories: h.attack.categories,
+		PlayedAt:   h.clock.Now(),
+	})
+	h.attack = nil
+}
+
+// recordScore ranks a game on the leaderboard of its mode.
+func (h *Hangman) recordScore(mode Mode, entry LeaderboardEntry) {
+	if h.leaderboardFile == "" {
+		return
+	}
+
+	board, err := ReadLeaderboard(h.leaderboardFile)
+	if err != nil {
+		logrus.WithError(err).Error("failed to read the leaderboard")
+		return
+	}
+	rank := board.Add(mode, entry)
+	if rank == 0 {
+		return
+	}
+	if err := WriteLeaderboard(h.leaderboardFile, board); err != nil {
+		logrus.WithError(err).Error("failed to write the leaderboard")
+		return
+	}
+	logrus.Infof("🏆 #%d on the %s leaderboard", rank, mode)
+}
@@ -0,0 +1,108 @@
+package hangman
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSummarizeRun(t *testing.T) {
+	results := []RoundResult{
+		{State: GameStateWin, Score: 40, Duration: 20 * time.Second},
+		{State: GameStateAbandon, Score: 5, Duration: 5 * time.Second},
+		{State: GameStateWin, Score: 30, Duration: 40 * time.Second},
+		{State: GameStateLose, Score: 10, Duration: 15 * time.Second},
+	}
+	want := RunResult{Solved: 2, Rounds: 4, Score: 85, Average: 30 * time.Second}
+	if got := SummarizeRun(results); got != want {
+		t.Errorf("SummarizeRun() = %+v, want %+v", got, want)
+	}
+	if got := SummarizeRun(nil); got != (RunResult{}) {
+		t.Errorf("SummarizeRun(nil) = %+v, want zero", got)
+	}
+}
+
+func TestHangman_timeAttack(t *testing.T) {
+	loader := NewWordLoader()
+	if err := loader.Load("testdata/groups"); err != nil {
+		t.Fatalf("WordLoader.Load() unexpected error = %v", err)
+	}
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	leaderboard := filepath.Join(t.TempDir(), "leaderboard.json")
+	h := &Hangman{
+		WordLoader:      loader,
+		scoring:         DefaultScoring(),
+		clock:           clock,
+		timeAttack:      TimeAttack{Duration: time.Minute},
+		leaderboardFile: leaderboard,
+	}
+
+	words, err := h.categoryWords([]string{"Animals"})
+	if err != nil {
+		t.Fatalf("categoryWords() unexpected error = %v", err)
+	}
+	if _, err := h.categoryWords([]string{"Missing"}); err == nil {
+		t.Error("categoryWords() of a missing category, want error")
+	}
+	h.startTimeAttack(words, []string{"Animals"})
+
+	clock.advance(20 * time.Second)
+	game, state, err := h.nextAttackRound()
+	if err != nil || state != GameStatePlaying {
+		t.Fatalf("nextAttackRound() = %v, %q, want a round", err, state)
+	}
+	if game.word.Category != "Animals" {
+		t.Errorf("word category = %q, want Animals", game.word.Category)
+	}
+	if game.timing.PerRound != 40*time.Second || game.scoring.TimeBonusPerSecond != 0 {
+		t.Errorf("round timing, bonus = %v, %d, want the 40s left on the run and no bonus",
+			game.timing.PerRound, game.scoring.TimeBonusPerSecond)
+	}
+
+	game.startClock()
+	game.solve(game.word.Text)
+	game.state = GameStateWin
+	h.finishRound(game)
+
+	clock.advance(time.Minute)
+	if _, state, err := h.nextAttackRound(); err != nil || state != GameStatePending {
+		t.Fatalf("nextAttackRound() after the run = %v, %q, want the menu", err, state)
+	}
+	if h.attack != nil {
+		t.Error("attack still running after the timer ran out")
+	}
+
+	board, err := ReadLeaderboard(leaderboard)
+	if err != nil {
+		t.Fatalf("ReadLeaderboard() unexpected error = %v", err)
+	}
+	if top := board.Top(ModeTimeAttack); len(top) != 1 || top[0].Solved != 1 || top[0].Score != game.score {
+		t.Errorf("time-attack leaderboard = %+v, want the run with 1 word solved", top)
+	}
+	if top := board.Top(ModeClassic); len(top) != 0 {
+		t.Errorf("classic leaderboard = %+v, want time-attack rounds left out", top)
+	}
+}
+
+func TestAttackRun_draw(t *testing.T) {
+	words := []Word{{Text: "Cat"}, {Text: "Dog"}, {Text: "Owl"}}
+	run := &attackRun{words: slices.Clone(words)}
+
+	last := ""
+	for cycle := range 20 {
+		drawn := []string{}
+		for range words {
+			word := run.draw()
+			if word.Text == last {
+				t.Fatalf("cycle %d drew %q twice in a row", cycle, word.Text)
+			}
+			last = word.Text
+			drawn = append(drawn, word.Text)
+		}
+		slices.Sort(drawn)
+		if !slices.Equal(drawn, []string{"Cat", "Dog", "Owl"}) {
+			t.Fatalf("cycle %d drew %v, want every word once", cycle, drawn)
+		}
+	}
+}
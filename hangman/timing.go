@@ -0,0 +1,216 @@
+package hangman
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultTimeBonusPerSecond is what every second left on the round clock is
+// worth when a timed round is won.
+const DefaultTimeBonusPerSecond = 2
+
+// Clock tells the time. Tests inject a fake one to keep timed rounds
+// deterministic.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the wall clock.
+type SystemClock struct{}
+
+// Now returns the current time.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Timing puts a round under time pressure. Zero durations disable a clock.
+type Timing struct {
+	// PerGuess is how long the player has for every entry; a late guess is
+	// discarded and costs a life.
+	PerGuess time.Duration
+	// PerRound is how long the whole round may last; the round is lost when
+	// it runs out.
+	PerRound time.Duration
+}
+
+// SetTiming sets the clocks of the round.
+func (g *HangmanGame) SetTiming(timing Timing) {
+	g.timing = timing
+}
+
+// SetClock replaces the clock of the round, keeping the time already elapsed.
+func (g *HangmanGame) SetClock(clock Clock) {
+	if !g.startedAt.IsZero() && g.finishedAt.IsZero() {
+		g.carried = g.elapsed()
+		g.startedAt = clock.Now()
+	}
+	g.clock = clock
+}
+
+// startClock starts the round clock when the round is first played.
+func (g *HangmanGame) startClock() {
+	g.startedAt = g.clock.Now()
+}
+
+// elapsed returns how long the round took, or has taken so far, including
+// the time played before it was saved.
+func (g *HangmanGame) elapsed() time.Duration {
+	if g.startedAt.IsZero() {
+		return g.carried
+	}
+	end := g.finishedAt
+	if end.IsZero() {
+		end = g.clock.Now()
+	}
+	return g.carried + end.Sub(g.startedAt)
+}
+
+// timeLeft returns what is left on the round clock, and false when the round
+// is not timed.
+func (g *HangmanGame) timeLeft() (time.Duration, bool) {
+	if g.timing.PerRound <= 0 {
+		return 0, false
+	}
+	return max(0, g.timing.PerRound-g.elapsed()), true
+}
+
+// timeUp reports whether the round clock has run out.
+func (g *HangmanGame) timeUp() bool {
+	left, timed := g.timeLeft()
+	return timed && left == 0
+}
+
+// guessExpired charges a life when an entry asked at the given time arrives
+// after the per-guess countdown, and reports whether it did.
+func (g *HangmanGame) guessExpired(asked time.Time) bool {
+	if g.timing.PerGuess <= 0 || g.clock.Now().Sub(asked) <= g.timing.PerGuess {
+		return false
+	}
+	g.lateGuess()
+	return true
+}
+
+// lateGuess charges a life for a guess made after the per-guess countdown.
+func (g *HangmanGame) lateGuess() {
+	g.remaining--
+	g.streak = 0
+	logrus.Warnf("⏰ Too slow, guesses must be made within %s", g.timing.PerGuess)
+}
+
+// inputDeadline returns how long the prompt may wait for the next entry: the
+// per-guess countdown or what is left on the round clock, whichever is
+// shorter. It reports false when the round is untimed.
+func (g *HangmanGame) inputDeadline() (time.Duration, bool) {
+	wait, timed := g.timing.PerGuess, g.timing.PerGuess > 0
+	if left, ok := g.timeLeft(); ok && (!timed || left < wait) {
+		wait, timed = left, true
+	}
+	return wait, timed
+}
+
+// expire ends a prompt that ran out of time without an entry: the round is
+// lost when its clock ran out, otherwise the guess is late and costs a life.
+func (g *HangmanGame) expire() (GameState, bool) {
+	if g.timeUp() {
+		logrus.Warn("⏰ Time is up!")
+		return GameStateLose, true
+	}
+	g.lateGuess()
+	return GameStateLose, g.remaining <= 0
+}
+
+// errInputExpired is returned by the guess prompt when its deadline passed.
+var errInputExpired = errors.New("no entry before the deadline")
+
+var (
+	stdinOnce   sync.Once
+	stdinChunks chan []byte
+)
+
+// stdinPump reads os.Stdin in the background for every prompt of the game,
+// so what is typed after a prompt gave up waiting reaches the next one.
+func stdinPump() <-chan []byte {
+	stdinOnce.Do(func() {
+		stdinChunks = make(chan []byte)
+		go func() {
+			for {
+				buf := make([]byte, 1024)
+				n, err := os.Stdin.Read(buf)
+				if n > 0 {
+					stdinChunks <- buf[:n]
+				}
+				if err != nil {
+					close(stdinChunks)
+					return
+				}
+			}
+		}()
+	})
+	return stdinChunks
+}
+
+// deadlineReader is the input of a game prompt. Once its deadline passes it
+// reports io.EOF, which ends the prompt, and remembers that it expired.
+type deadlineReader struct {
+	chunks  <-chan []byte
+	timer   *time.Timer
+	timeout <-chan time.Time
+	pending []byte
+	expired atomic.Bool
+}
+
+// newDeadlineReader reads chunks until wait has passed; a zero wait never
+// expires.
+func newDeadlineReader(chunks <-chan []byte, wait time.Duration) *deadlineReader {
+	r := &deadlineReader{chunks: chunks}
+	if wait > 0 {
+		r.timer = time.NewTimer(wait)
+		r.timeout = r.timer.C
+	}
+	return r
+}
+
+// Read returns the next input, or io.EOF once the deadline passed or the
+// input was closed.
+func (r *deadlineReader) Read(b []byte) (int, error) {
+	if len(r.pending) == 0 {
+		select {
+		case chunk, ok := <-r.chunks:
+			if !ok {
+				return 0, io.EOF
+			}
+			r.pending = chunk
+		case <-r.timeout:
+			r.expired.Store(true)
+			return 0, io.EOF
+		}
+	}
+	n := copy(b, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// Close stops the deadline timer.
+func (r *deadlineReader) Close() error {
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+	return nil
+}
+
+// addTimeBonus scores the seconds left on the round clock.
+func (g *HangmanGame) addTimeBonus() {
+	left, timed := g.timeLeft()
+	if !timed {
+		return
+	}
+	seconds := int(left / time.Second)
+	g.addPoints(seconds*g.scoring.TimeBonusPerSecond, fmt.Sprintf("time bonus, %s left", plural(seconds, "second")))
+}
@@ -0,0 +1,155 @@
+package hangman
+
+import (
+	"io"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock that only moves when advanced.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTimedGame(t *testing.T, timing Timing) (*HangmanGame, *fakeClock) {
+	t.Helper()
+	game, err := NewHangmanGame(&Word{Text: "Owl", Hint: "A bird"}, 3)
+	if err != nil {
+		t.Fatalf("NewHangmanGame() unexpected error = %v", err)
+	}
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	game.SetScoring(Scoring{PointsPerCorrectGuess: 10, TimeBonusPerSecond: 2})
+	game.SetTiming(timing)
+	game.SetClock(clock)
+	game.startClock()
+	return game, clock
+}
+
+func TestHangmanGame_handle_timing(t *testing.T) {
+	type entry struct {
+		guess string
+		delay time.Duration
+	}
+
+	tests := []struct {
+		name          string
+		timing        Timing
+		entries       []entry
+		wantState     GameState
+		wantDone      bool
+		wantRemaining int
+		wantScore     int
+	}{
+		{"untimed", Timing{}, []entry{{"o", time.Hour}}, GameStateLose, false, 6, 10},
+		{"guess in time", Timing{PerGuess: 10 * time.Second}, []entry{{"o", 10 * time.Second}}, GameStateLose, false, 6, 10},
+		{"late guess costs a life", Timing{PerGuess: 10 * time.Second}, []entry{{"o", 11 * time.Second}}, GameStateLose, false, 5, 0},
+		{"commands are never late", Timing{PerGuess: 10 * time.Second}, []entry{{":help", time.Minute}}, "", false, 6, 0},
+		{"late solve costs a life", Timing{PerGuess: 10 * time.Second}, []entry{{":solve owl", time.Minute}}, GameStateLose, false, 5, 0},
+		{"round clock runs out", Timing{PerRound: time.Minute}, []entry{{"o", 30 * time.Second}, {"w", 31 * time.Second}}, GameStateLose, true, 6, 10},
+		{"time bonus on win", Timing{PerRound: time.Minute}, []entry{{"ow", 20 * time.Second}, {"l", 10 * time.Second}}, GameStateWin, true, 6, 60 + 30*2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game, clock := newTimedGame(t, tt.timing)
+
+			var state GameState
+			var done bool
+			for _, e := range tt.entries {
+				asked := clock.Now()
+				clock.advance(e.delay)
+				state, done = game.handle(e.guess, asked)
+			}
+			if state != tt.wantState || done != tt.wantDone {
+				t.Errorf("handle() = %q, %v, want %q, %v", state, done, tt.wantState, tt.wantDone)
+			}
+			if game.remaining != tt.wantRemaining {
+				t.Errorf("remaining = %d, want %d", game.remaining, tt.wantRemaining)
+			}
+			if game.score != tt.wantScore {
+				t.Errorf("score = %d, want %d", game.score, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestHangmanGame_SetClock(t *testing.T) {
+	game, clock := newTimedGame(t, Timing{PerRound: time.Minute})
+	clock.advance(20 * time.Second)
+
+	other := &fakeClock{now: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}
+	game.SetClock(other)
+	if got := game.elapsed(); got != 20*time.Second {
+		t.Errorf("elapsed() = %v, want the 20s elapsed before the clock changed", got)
+	}
+	if left, timed := game.timeLeft(); !timed || left != 40*time.Second {
+		t.Errorf("timeLeft() = %v, %v, want 40s, true", left, timed)
+	}
+}
+
+func TestHangmanGame_expire(t *testing.T) {
+	tests := []struct {
+		name          string
+		timing        Timing
+		elapsed       time.Duration
+		wantWait      time.Duration
+		wantTimed     bool
+		wantDone      bool
+		wantRemaining int
+	}{
+		{"untimed", Timing{}, 0, 0, false, false, 5},
+		{"guess clock", Timing{PerGuess: 10 * time.Second}, 0, 10 * time.Second, true, false, 5},
+		{"round clock is shorter", Timing{PerGuess: 10 * time.Second, PerRound: time.Minute}, 55 * time.Second, 5 * time.Second, true, false, 5},
+		{"round clock runs out", Timing{PerRound: time.Minute}, time.Minute, 0, true, true, 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game, clock := newTimedGame(t, tt.timing)
+			clock.advance(tt.elapsed)
+			if wait, timed := game.inputDeadline(); wait != tt.wantWait || timed != tt.wantTimed {
+				t.Errorf("inputDeadline() = %v, %v, want %v, %v", wait, timed, tt.wantWait, tt.wantTimed)
+			}
+
+			state, done := game.expire()
+			if state != GameStateLose || done != tt.wantDone {
+				t.Errorf("expire() = %q, %v, want %q, %v", state, done, GameStateLose, tt.wantDone)
+			}
+			if game.remaining != tt.wantRemaining {
+				t.Errorf("remaining = %d, want %d", game.remaining, tt.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestDeadlineReader(t *testing.T) {
+	chunks := make(chan []byte, 1)
+	chunks <- []byte("owl\n")
+
+	reader := newDeadlineReader(chunks, 20*time.Millisecond)
+	defer reader.Close()
+	buf := make([]byte, 2)
+	if n, err := reader.Read(buf); err != nil || string(buf[:n]) != "ow" {
+		t.Fatalf("Read() = %q, %v, want \"ow\"", buf[:n], err)
+	}
+	if n, err := reader.Read(buf); err != nil || string(buf[:n]) != "l\n" {
+		t.Fatalf("Read() = %q, %v, want the rest of the chunk", buf[:n], err)
+	}
+	if _, err := reader.Read(buf); err != io.EOF || !reader.expired.Load() {
+		t.Errorf("Read() after the deadline = %v, expired %v, want io.EOF, true", err, reader.expired.Load())
+	}
+
+	close(chunks)
+	untimed := newDeadlineReader(chunks, 0)
+	if _, err := untimed.Read(buf); err != io.EOF || untimed.expired.Load() {
+		t.Errorf("Read() of closed input = %v, expired %v, want io.EOF, false", err, untimed.expired.Load())
+	}
+}
@@ -10,3 +10,9 @@ func IsAlphabet(s string) bool {
 	}
 	return true
 }
+
+// IsPlayable reports whether r can appear in an answer: either an ASCII letter
+// the player can guess, or printable ASCII that is revealed up-front.
+func IsPlayable(r rune) bool {
+	return r <= unicode.MaxASCII && unicode.IsPrint(r)
+}
@@ -37,3 +37,28 @@ func TestIsAlphabet(t *testing.T) {
 		})
 	}
 }
+
+func TestIsPlayable(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    rune
+		expected bool
+	}{
+		{"ascii letter", 'a', true},
+		{"digit", '7', true},
+		{"space", ' ', true},
+		{"ampersand", '&', true},
+		{"accented letter", 'é', false},
+		{"tab", '\t', false},
+		{"emoji", '🎉', false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsPlayable(tt.input)
+			if result != tt.expected {
+				t.Errorf("IsPlayable(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
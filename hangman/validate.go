@@ -0,0 +1,233 @@
+package hangman
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	DefaultMaxWordLength = 40
+	DefaultMaxHintLength = 120
+)
+
+// Severity classifies how serious a validation issue is.
+type Severity string
+
+const (
+	SeverityError   = Severity("error")
+	SeverityWarning = Severity("warning")
+)
+
+// Names of the checks performed by Validate.
+const (
+	CheckFormat            = "format"
+	CheckDuplicateWord     = "duplicate-word"
+	CheckDuplicateAcross   = "duplicate-across-categories"
+	CheckDuplicateCategory = "duplicate-category"
+	CheckEmptyHint         = "empty-hint"
+	CheckHintRevealsAnswer = "hint-reveals-answer"
+	CheckUnplayable        = "unplayable-character"
+	CheckTooLong           = "too-long"
+)
+
+// Issue is a single problem reported by Validate.
+type Issue struct {
+	Diagnostic
+	Severity Severity `json:"severity"`
+	Check    string   `json:"check"`
+}
+
+// String formats the issue as "path:line: severity: reason [check]".
+func (i Issue) String() string {
+	location := i.Path
+	if i.Line > 0 {
+		location = fmt.Sprintf("%s:%d", i.Path, i.Line)
+	}
+	return fmt.Sprintf("%s: %s: %s [%s]", location, i.Severity, i.Reason, i.Check)
+}
+
+// ValidateOptions tunes the limits applied by Validate.
+type ValidateOptions struct {
+	MaxWordLength int
+	MaxHintLength int
+	// Secret decrypts encrypted word files so their content can be checked.
+	Secret Secret
+}
+
+// DefaultValidateOptions returns the limits used by the validate command.
+func DefaultValidateOptions() ValidateOptions {
+	return ValidateOptions{
+		MaxWordLength: DefaultMaxWordLength,
+		MaxHintLength: DefaultMaxHintLength,
+	}
+}
+
+// ValidationReport summarises the result of validating one or more word packs.
+type ValidationReport struct {
+	Files      int     `json:"files"`
+	Categories int     `json:"categories"`
+	Words      int     `json:"words"`
+	Issues     []Issue `json:"issues"`
+}
+
+// Count returns the number of issues with the given severity.
+func (r *ValidationReport) Count(severity Severity) int {
+	count := 0
+	for _, issue := range r.Issues {
+		if issue.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
+// HasErrors reports whether any error-level issue was found.
+func (r *ValidationReport) HasErrors() bool {
+	return r.Count(SeverityError) > 0
+}
+
+// validator holds the state shared across all files of a validation run.
+type validator struct {
+	opts       ValidateOptions
+	report     *ValidationReport
+	categories map[string]string
+	inCategory map[string]Source
+	anywhere   map[string]string
+	firstSeen  map[string]Source
+}
+
+// Validate checks every word file under the given paths and reports
+// formatting errors, duplicates and content problems. Pack manifests, keys and
+// hidden files are skipped, as the loader skips them.
+// Files are parsed with LoadFile in lenient mode so that every problem is reported.
+func Validate(paths []string, opts ValidateOptions) (*ValidationReport, error) {
+	v := &validator{
+		opts:       opts,
+		report:     &ValidationReport{Issues: []Issue{}},
+		categories: make(map[string]string),
+		inCategory: make(map[string]Source),
+		anywhere:   make(map[string]string),
+		firstSeen:  make(map[string]Source),
+	}
+
+	for _, root := range paths {
+		err := filepath.Walk(root, func(path string, info fs.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				if path != root && strings.HasPrefix(info.Name(), ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !isWordFile(info.Name()) {
+				return nil
+			}
+
+			v.validateFile(path)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return v.report, nil
+}
+
+// validateFile loads a single file and runs every check against it.
+func (v *validator) validateFile(path string) {
+	loader := NewWordLoader()
+	loader.SetMode(LoadModeLenient)
+	loader.SetSecret(v.opts.Secret)
+
+	v.report.Files++
+	category, words, _ := loader.LoadFile(path)
+	for _, diag := range loader.Diagnostics() {
+		v.add(diag, SeverityError, CheckFormat)
+	}
+	if category == "" {
+		return
+	}
+
+	v.report.Categories++
+	if first, ok := v.categories[category]; ok {
+		v.add(Diagnostic{Path: path, Line: 1, Reason: fmt.Sprintf("duplicate category title %q, also declared in %s", category, first)},
+			SeverityWarning, CheckDuplicateCategory)
+	} else {
+		v.categories[category] = path
+	}
+
+	for _, word := range words {
+		v.report.Words++
+		v.validateWord(category, word)
+	}
+}
+
+// validateWord runs the per-word checks.
+func (v *validator) validateWord(category string, word Word) {
+	at := func(reason string) Diagnostic {
+		return Diagnostic{Path: word.Source.Path, Line: word.Source.Line, Reason: reason}
+	}
+
+	key := strings.ToLower(word.Text)
+	if first, ok := v.inCategory[category+"\x00"+key]; ok {
+		v.add(at(fmt.Sprintf("duplicate word %q in category %q, first defined at %s:%d", word.Text, category, first.Path, first.Line)),
+			SeverityError, CheckDuplicateWord)
+	} else {
+		v.inCategory[category+"\x00"+key] = word.Source
+	}
+
+	if other, ok := v.anywhere[key]; ok && other != category {
+		first := v.firstSeen[key]
+		v.add(at(fmt.Sprintf("word %q also appears in category %q at %s:%d", word.Text, other, first.Path, first.Line)),
+			SeverityWarning, CheckDuplicateAcross)
+	} else if !ok {
+		v.anywhere[key] = category
+		v.firstSeen[key] = word.Source
+	}
+
+	if word.Hint == "" {
+		v.add(at(fmt.Sprintf("word %q has an empty hint, structural hints will be generated", word.Text)), SeverityWarning, CheckEmptyHint)
+	} else {
+		v.checkHintsReveal(word, at)
+	}
+
+	for _, r := range word.Text {
+		if !IsPlayable(r) {
+			v.add(at(fmt.Sprintf("word %q contains unplayable character %q", word.Text, r)), SeverityError, CheckUnplayable)
+			break
+		}
+	}
+
+	if v.opts.MaxWordLength > 0 && len(word.Text) > v.opts.MaxWordLength {
+		v.add(at(fmt.Sprintf("word %q is %d characters long, limit is %d", word.Text, len(word.Text), v.opts.MaxWordLength)),
+			SeverityError, CheckTooLong)
+	}
+	for _, hint := range word.Hints() {
+		if v.opts.MaxHintLength > 0 && len(hint) > v.opts.MaxHintLength {
+			v.add(at(fmt.Sprintf("hint for %q is %d characters long, limit is %d", word.Text, len(hint), v.opts.MaxHintLength)),
+				SeverityWarning, CheckTooLong)
+		}
+	}
+}
+
+// checkHintsReveal reports the first hint that contains the word or one of its aliases.
+func (v *validator) checkHintsReveal(word Word, at func(string) Diagnostic) {
+	for _, hint := range word.Hints() {
+		for _, answer := range append([]string{word.Text}, word.Aliases...) {
+			if strings.Contains(strings.ToLower(hint), strings.ToLower(answer)) {
+				v.add(at(fmt.Sprintf("hint %q contains the answer %q", hint, answer)), SeverityError, CheckHintRevealsAnswer)
+				return
+			}
+		}
+	}
+}
+
+// add appends an issue to the report.
+func (v *validator) add(diag Diagnostic, severity Severity, check string) {
+	v.report.Issues = append(v.report.Issues, Issue{Diagnostic: diag, Severity: severity, Check: check})
+}
@@ -2,38 +2,114 @@ package hangman
 
 import (
 	"bufio"
+	"bytes"
+	"cmp"
 	"errors"
+	"fmt"
+	"io"
 	"io/fs"
 	"math/rand"
 	"os"
 	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"unicode"
 )
 
+// AliasSeparator separates a word from its aliases in word files, e.g.
+// "Tottenham Hotspur|Spurs".
+const AliasSeparator = "|"
+
+// HintSeparator separates progressive hints in word files, e.g.
+// "Football club|Plays in north London|Nicknamed Spurs".
+const HintSeparator = "|"
+
 // Word represents a word with its hint.
 type Word struct {
+	// Text is the canonical answer the player guesses letter by letter.
 	Text string
+	// Aliases are alternative answers accepted when solving the whole word.
+	Aliases []string
+	// Hint is shown when the round starts.
 	Hint string
+	// ExtraHints are revealed one at a time on request, in order.
+	ExtraHints []string
+	Category   string
+	Tags       []string
+	Source     Source
+
+	meta WordMeta
+}
+
+// Source records where a word was loaded from.
+type Source struct {
+	Path string
+	Line int
+}
+
+// Hints returns every hint of the word, the free one first.
+func (w *Word) Hints() []string {
+	return append([]string{w.Hint}, w.ExtraHints...)
+}
+
+// Accepts reports whether attempt solves the word: it matches the text or an
+// alias, ignoring case, spacing and punctuation.
+func (w *Word) Accepts(attempt string) bool {
+	key := normalizeAnswer(attempt)
+	if key == "" {
+		return false
+	}
+
+	for _, answer := range append([]string{w.Text}, w.Aliases...) {
+		if normalizeAnswer(answer) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeAnswer lowercases s and keeps only its letters and digits.
+func normalizeAnswer(s string) string {
+	builder := new(strings.Builder)
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
 }
 
 // Indices returns a map of letters to their positions in the word.
 func (w *Word) Indices() map[string][]int {
+	return letterIndices(w.Text)
+}
+
+// PreAnswer generates the initial answer slice with underscores.
+func (w *Word) PreAnswer() []string {
+	return maskedAnswer(w.Text)
+}
+
+// letterIndices maps each lowercase character of text to its byte offsets.
+func letterIndices(text string) map[string][]int {
 	indices := make(map[string][]int)
-	for i, ch := range w.Text {
+	for i, ch := range text {
 		letter := strings.ToLower(string(ch))
 		indices[letter] = append(indices[letter], i)
 	}
 	return indices
 }
 
-// PreAnswer generates the initial answer slice with underscores.
-func (w *Word) PreAnswer() []string {
-	answer := make([]string, len(w.Text))
-	for i := 0; i < len(w.Text); i++ {
-		if IsAlphabet(string(w.Text[i])) {
+// maskedAnswer returns one entry per byte of text, with letters hidden as "_".
+func maskedAnswer(text string) []string {
+	answer := make([]string, len(text))
+	for i := 0; i < len(text); i++ {
+		if IsAlphabet(string(text[i])) {
 			answer[i] = "_"
 		} else {
-			answer[i] = string(w.Text[i])
+			answer[i] = string(text[i])
 		}
 	}
 	return answer
@@ -49,79 +125,460 @@ func (w *Word) AlphabetLength() int {
 	return count
 }
 
+// LoadMode controls how WordLoader reacts to malformed word files.
+type LoadMode string
+
+const (
+	// LoadModeStrict aborts loading on the first problem.
+	LoadModeStrict = LoadMode("strict")
+	// LoadModeLenient skips broken lines and files and collects every problem.
+	LoadModeLenient = LoadMode("lenient")
+)
+
+// CategoryOrder controls the order in which Categories returns titles.
+type CategoryOrder string
+
+const (
+	// OrderFile keeps categories in the order their files were loaded.
+	OrderFile = CategoryOrder("file")
+	// OrderAlphabetical sorts categories by title, ignoring case.
+	OrderAlphabetical = CategoryOrder("alphabetical")
+	// OrderExplicit sorts by the "@order" directive; categories without one follow in file order.
+	OrderExplicit = CategoryOrder("explicit")
+	// OrderMostPlayed sorts by the number of recorded plays, most first.
+	OrderMostPlayed = CategoryOrder("most-played")
+)
+
 // WordLoader is responsible for loading words from files.
 type WordLoader struct {
+	mode          LoadMode
+	order         CategoryOrder
 	categories    []string
 	categoryWords map[string][]Word
+	categoryGroup map[string]string
+	categoryIndex map[string]int32
+	byLength      map[int][]wordRef
+	byTag         map[string][]wordRef
+	groups        []string
+	explicitOrder map[string]int
+	plays         map[string]int
+	policy        PackPolicy
+	secret        Secret
+	diagnostics   Diagnostics
 }
 
-// NewWordLoader creates a new instance of WordLoader.
+// NewWordLoader creates a new instance of WordLoader in strict mode.
 func NewWordLoader() *WordLoader {
 	return &WordLoader{
+		mode:          LoadModeStrict,
+		order:         OrderFile,
 		categories:    []string{},
 		categoryWords: make(map[string][]Word),
+		categoryGroup: make(map[string]string),
+		categoryIndex: make(map[string]int32),
+		byLength:      make(map[int][]wordRef),
+		byTag:         make(map[string][]wordRef),
+		explicitOrder: make(map[string]int),
+		plays:         make(map[string]int),
 	}
 }
 
+// SetMode changes how subsequent loads handle malformed files.
+func (l *WordLoader) SetMode(mode LoadMode) {
+	l.mode = mode
+}
+
+// SetOrder changes the order in which Categories returns titles.
+func (l *WordLoader) SetOrder(order CategoryOrder) {
+	l.order = order
+}
+
+// SetPackPolicy sets the verification rules applied by LoadPack.
+func (l *WordLoader) SetPackPolicy(policy PackPolicy) {
+	l.policy = policy
+}
+
+// SetSecret sets the key or passphrase used to decrypt encrypted word files.
+func (l *WordLoader) SetSecret(secret Secret) {
+	l.secret = secret
+}
+
+// RecordPlay counts a game played in the category, used by OrderMostPlayed.
+func (l *WordLoader) RecordPlay(category string) {
+	l.plays[category]++
+}
+
+// Diagnostics returns the problems collected by previous loads.
+func (l *WordLoader) Diagnostics() Diagnostics {
+	return l.diagnostics
+}
+
 // Load loads words from the specified directory path.
+// Files declaring the same category title are merged into one category, and
+// subdirectories become category groups named by their slash-separated path
+// relative to the loaded directory (e.g. "sports/football").
+// In strict mode the first problem is returned as a Diagnostic; in lenient
+// mode broken files are skipped and only a failure to walk the directory
+// itself is returned.
 func (l *WordLoader) Load(path string) error {
-	return filepath.Walk(path, func(path string, info fs.FileInfo, err error) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		return l.loadFS(os.DirFS(filepath.Dir(path)), filepath.Base(path), filepath.Dir(path))
+	}
+	return l.loadFS(os.DirFS(path), ".", path)
+}
+
+// LoadFS loads words from the tree rooted at root inside fsys, following the
+// same rules as Load. It allows word packs to come from an embed.FS, a zip
+// archive or an in-memory test fixture.
+func (l *WordLoader) LoadFS(fsys fs.FS, root string) error {
+	return l.loadFS(fsys, root, "")
+}
+
+// isWordFile reports whether a file found in a data directory may hold words.
+// Pack manifests, signing and encryption keys and hidden files such as the
+// .disabled marker of a pack are not word files.
+func isWordFile(name string) bool {
+	switch {
+	case name == ManifestFile, strings.HasPrefix(name, "."):
+		return false
+	case strings.HasSuffix(name, ".key"), strings.HasSuffix(name, ".pub"):
+		return false
+	}
+	return true
+}
+
+// loadFS walks fsys from root. When base is set, it is joined to file names
+// so diagnostics and sources show the on-disk path. Files are parsed in
+// parallel and merged in walk order, so results do not depend on scheduling.
+func (l *WordLoader) loadFS(fsys fs.FS, root, base string) error {
+	names := []string{}
+	err := fs.WalkDir(fsys, root, func(name string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
 
-		if info.IsDir() {
+		if d.IsDir() {
+			if name != root && strings.HasPrefix(d.Name(), ".") {
+				return fs.SkipDir
+			}
 			return nil
 		}
-
-		category, words, err := l.LoadFile(path)
-		if err != nil {
-			return err
+		if isWordFile(d.Name()) {
+			names = append(names, name)
 		}
-
-		l.categoryWords[category] = append(l.categoryWords[category], words...)
-		l.categories = append(l.categories, category)
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	parsers := make([]*fileParser, len(names))
+	files := make([]*wordFile, len(names))
+	errs := make([]error, len(names))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(runtime.GOMAXPROCS(0), len(names)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				display := names[i]
+				if base != "" {
+					display = filepath.Join(base, filepath.FromSlash(names[i]))
+				}
+
+				parsers[i] = l.newParser()
+				files[i], errs[i] = parsers[i].load(fsys, names[i], display)
+			}
+		}()
+	}
+	for i := range names {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	for i, name := range names {
+		l.diagnostics = append(l.diagnostics, parsers[i].diagnostics...)
+		if errs[i] != nil {
+			if l.mode == LoadModeLenient {
+				continue
+			}
+			return errs[i]
+		}
+
+		l.addCategory(groupOf(root, name), files[i])
+	}
+
+	return nil
+}
+
+// addCategory merges a parsed file into the loaded categories under the given group.
+func (l *WordLoader) addCategory(group string, wf *wordFile) {
+	if _, ok := l.categoryWords[wf.category]; !ok {
+		l.categories = append(l.categories, wf.category)
+		l.categoryGroup[wf.category] = group
+		l.addGroup(group)
+	}
+	start := len(l.categoryWords[wf.category])
+	l.categoryWords[wf.category] = append(l.categoryWords[wf.category], wf.words...)
+	l.indexWords(wf.category, start)
+
+	if _, ok := l.explicitOrder[wf.category]; !ok && wf.order != nil {
+		l.explicitOrder[wf.category] = *wf.order
+	}
 }
 
-// LoadFile is a stub function for loading words from a file. which will returned the category, words slice and error.
+// wordFile is the parsed content of a single word file.
+type wordFile struct {
+	category string
+	words    []Word
+	order    *int
+}
+
+// LoadFile loads a single word file, returning its category and words.
+// The first line is the category title and every following line is either
+// a "word[|alias...],hint[|hint...][,[tags]]" entry, a directive such as
+// "@order 2", a "#" comment or blank. Encrypted files are decrypted in
+// memory with the loader's Secret. Problems are recorded in Diagnostics; in
+// lenient mode malformed lines are skipped instead of failing the whole file.
 func (l *WordLoader) LoadFile(path string) (string, []Word, error) {
-	file, err := os.Open(path)
+	parser := l.newParser()
+	wf, err := parser.load(os.DirFS(filepath.Dir(path)), filepath.Base(path), path)
+	l.diagnostics = append(l.diagnostics, parser.diagnostics...)
 	if err != nil {
 		return "", nil, err
 	}
+	return wf.category, wf.words, nil
+}
+
+// fileParser parses word files with the settings of a WordLoader. Each
+// parser collects its own diagnostics so files can be parsed concurrently.
+type fileParser struct {
+	mode        LoadMode
+	secret      Secret
+	diagnostics Diagnostics
+}
+
+// newParser creates a parser using the loader's mode and secret.
+func (l *WordLoader) newParser() *fileParser {
+	return &fileParser{mode: l.mode, secret: l.secret}
+}
+
+// load opens name inside fsys and parses it, reporting problems against display.
+// Plain files are streamed; only encrypted files are read fully into memory.
+func (p *fileParser) load(fsys fs.FS, name, display string) (*wordFile, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, p.report(Diagnostic{Path: display, Reason: err.Error()})
+	}
 	defer file.Close()
 
-	scanner := bufio.NewScanner(file)
+	reader := bufio.NewReader(file)
+	if magic, _ := reader.Peek(len(encryptedMagic)); IsEncrypted(magic) {
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, p.report(Diagnostic{Path: display, Reason: err.Error()})
+		}
+		return p.parseData(data, display)
+	}
+
+	return p.parse(reader, display)
+}
+
+// parseData decrypts data in memory when it is an encrypted word file and parses it.
+func (p *fileParser) parseData(data []byte, path string) (*wordFile, error) {
+	if IsEncrypted(data) {
+		plain, err := DecryptWords(data, p.secret)
+		if err != nil {
+			return nil, p.report(Diagnostic{Path: path, Reason: err.Error()})
+		}
+		data = plain
+	}
+
+	return p.parse(bytes.NewReader(data), path)
+}
+
+// parse parses a word file including its directives.
+func (p *fileParser) parse(r io.Reader, path string) (*wordFile, error) {
+	scanner := bufio.NewScanner(r)
 
-	category := ""
-	words := []Word{}
+	wf := &wordFile{words: []Word{}}
+	lineNo := 0
 
 	for scanner.Scan() {
+		lineNo++
 		line := scanner.Text()
-		if category == "" {
-			category = line
-		} else {
-			texts := strings.Split(line, ",")
+		if wf.category == "" {
+			wf.category = line
+			continue
+		}
 
-			if len(texts) != 2 {
-				return "", nil, errors.New("invalid word format in file, expected 'word,hint'")
+		if isCommentLine(line) {
+			continue
+		}
+
+		var reason string
+		if strings.HasPrefix(line, "@") {
+			reason = wf.parseDirective(line)
+		} else {
+			var word Word
+			word, reason = parseWordLine(line)
+			if reason == "" {
+				word.Category = wf.category
+				word.Source = Source{Path: path, Line: lineNo}
+				word.meta = computeMeta(word.Text)
+				wf.words = append(wf.words, word)
 			}
+		}
 
-			words = append(words, Word{Text: strings.TrimSpace(texts[0]), Hint: strings.TrimSpace(texts[1])})
+		if reason != "" {
+			diag := p.report(Diagnostic{Path: path, Line: lineNo, Reason: reason})
+			if p.mode != LoadModeLenient {
+				return nil, diag
+			}
 		}
 	}
 
 	if err := scanner.Err(); err != nil {
-		return "", nil, err
+		return nil, p.report(Diagnostic{Path: path, Line: lineNo, Reason: err.Error()})
+	}
+
+	if wf.category == "" || len(wf.words) == 0 {
+		return nil, p.report(Diagnostic{Path: path, Reason: "file is missing category or words"})
+	}
+
+	return wf, nil
+}
+
+// report records a diagnostic and returns it as an error.
+func (p *fileParser) report(diag Diagnostic) error {
+	p.diagnostics = append(p.diagnostics, diag)
+	return diag
+}
+
+// isCommentLine reports whether a line after the category title is blank or a "#" comment.
+func isCommentLine(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed == "" || strings.HasPrefix(trimmed, "#")
+}
+
+// parseDirective applies an "@name value" line, returning a non-empty reason if it is invalid.
+func (wf *wordFile) parseDirective(line string) string {
+	name, value, _ := strings.Cut(strings.TrimPrefix(line, "@"), " ")
+	switch name {
+	case "order":
+		order, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Sprintf("invalid @order value %q, expected an integer", value)
+		}
+		wf.order = &order
+		return ""
+	default:
+		return fmt.Sprintf("unknown directive @%s", name)
+	}
+}
+
+// parseWordLine parses a "word[|alias...][,hint[|hint...][,[tags]]]" line,
+// returning a non-empty reason if it is malformed. Tags are separated by
+// spaces inside brackets, so a stray comma in a hint is still reported. A
+// word without a hint is played with StructuralHints.
+func parseWordLine(line string) (Word, string) {
+	texts := strings.Split(line, ",")
+	var tags []string
+	if len(texts) == 3 {
+		field := strings.TrimSpace(texts[2])
+		if strings.HasPrefix(field, "[") && strings.HasSuffix(field, "]") {
+			tags = strings.Fields(field[1 : len(field)-1])
+			texts = texts[:2]
+		}
+	}
+	if len(texts) > 2 {
+		return Word{}, fmt.Sprintf("invalid word format, expected 'word,hint' but found %d field(s)", len(texts))
+	}
+	if len(texts) == 1 {
+		texts = append(texts, "")
+	}
+
+	var word Word
+	var reason string
+	word.Text, word.Aliases, reason = splitAliases(texts[0])
+	if reason != "" {
+		return Word{}, reason
+	}
+
+	hints := strings.Split(texts[1], HintSeparator)
+	for i := range hints {
+		hints[i] = strings.TrimSpace(hints[i])
+	}
+	if len(hints) > 1 && slices.Contains(hints, "") {
+		return Word{}, fmt.Sprintf("word %q has an empty hint in its hint list", word.Text)
+	}
+	word.Hint = hints[0]
+	if len(hints) > 1 {
+		word.ExtraHints = hints[1:]
+	}
+	word.Tags = tags
+
+	return word, ""
+}
+
+// cleanWord trims the answers and hints of a word read from a WordSource,
+// returning a non-empty reason if parseWordLine would reject it.
+func cleanWord(word *Word) string {
+	word.Text = strings.TrimSpace(word.Text)
+	if word.Text == "" {
+		return "word cannot be empty"
+	}
+	word.Aliases = slices.Clone(word.Aliases)
+	for i := range word.Aliases {
+		word.Aliases[i] = strings.TrimSpace(word.Aliases[i])
+		if word.Aliases[i] == "" {
+			return fmt.Sprintf("word %q has an empty alias", word.Text)
+		}
 	}
 
-	if category == "" || len(words) == 0 {
-		return "", nil, errors.New("file is missing category or words")
+	word.Hint = strings.TrimSpace(word.Hint)
+	word.ExtraHints = slices.Clone(word.ExtraHints)
+	for i := range word.ExtraHints {
+		word.ExtraHints[i] = strings.TrimSpace(word.ExtraHints[i])
 	}
+	if len(word.ExtraHints) > 0 && (word.Hint == "" || slices.Contains(word.ExtraHints, "")) {
+		return fmt.Sprintf("word %q has an empty hint in its hint list", word.Text)
+	}
+	return ""
+}
 
-	return category, words, nil
+// splitAliases splits "word|alias..." into the canonical word and its
+// aliases, returning a non-empty reason if any of them is empty.
+func splitAliases(field string) (string, []string, string) {
+	answers := strings.Split(field, AliasSeparator)
+	for i := range answers {
+		answers[i] = strings.TrimSpace(answers[i])
+	}
+
+	if answers[0] == "" {
+		return "", nil, "word cannot be empty"
+	}
+	if slices.Contains(answers[1:], "") {
+		return "", nil, fmt.Sprintf("word %q has an empty alias", answers[0])
+	}
+	if len(answers) == 1 {
+		return answers[0], nil, ""
+	}
+	return answers[0], answers[1:], ""
+}
+
+// report records a diagnostic and returns it as an error.
+func (l *WordLoader) report(diag Diagnostic) error {
+	l.diagnostics = append(l.diagnostics, diag)
+	return diag
 }
 
 // GetWords retrieves words for a given category.
@@ -138,9 +595,40 @@ func (l *WordLoader) GetWords(category string) ([]Word, error) {
 	return words, nil
 }
 
-// Categories returns the list of available categories.
+// Categories returns the list of available categories, sorted according
+// to the loader's CategoryOrder. Ties keep file order.
 func (l *WordLoader) Categories() []string {
-	return l.categories
+	return l.sortCategories(slices.Clone(l.categories))
+}
+
+// sortCategories sorts categories in place according to the loader's CategoryOrder.
+func (l *WordLoader) sortCategories(categories []string) []string {
+	switch l.order {
+	case OrderAlphabetical:
+		slices.SortStableFunc(categories, func(a, b string) int {
+			return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+		})
+	case OrderExplicit:
+		slices.SortStableFunc(categories, func(a, b string) int {
+			orderA, okA := l.explicitOrder[a]
+			orderB, okB := l.explicitOrder[b]
+			switch {
+			case okA && okB:
+				return cmp.Compare(orderA, orderB)
+			case okA:
+				return -1
+			case okB:
+				return 1
+			}
+			return 0
+		})
+	case OrderMostPlayed:
+		slices.SortStableFunc(categories, func(a, b string) int {
+			return cmp.Compare(l.plays[b], l.plays[a])
+		})
+	}
+
+	return categories
 }
 
 // RandomWord retrieves a random word from the specified category.
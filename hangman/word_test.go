@@ -1,10 +1,13 @@
 package hangman
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
+	"testing/fstest"
 )
 
 func TestWord_Indices(t *testing.T) {
@@ -202,7 +205,7 @@ func TestWordLoader_LoadFile(t *testing.T) {
 			wantErr:  true,
 		},
 		{
-			name:     "invalid format - no comma",
+			name:     "invalid format - no word",
 			filePath: "testdata/invalid.txt",
 			wantErr:  true,
 		},
@@ -424,3 +427,247 @@ func TestWordLoader_Integration(t *testing.T) {
 		}
 	}
 }
+
+func TestWordLoader_LoadFileLenient(t *testing.T) {
+	loader := NewWordLoader()
+	loader.SetMode(LoadModeLenient)
+
+	category, words, err := loader.LoadFile("testdata/partial.txt")
+	if err != nil {
+		t.Fatalf("WordLoader.LoadFile() unexpected error = %v", err)
+	}
+	if category != "Partial" {
+		t.Errorf("WordLoader.LoadFile() category = %v, want Partial", category)
+	}
+	if len(words) != 2 {
+		t.Errorf("WordLoader.LoadFile() word count = %v, want 2", len(words))
+	}
+
+	diags := loader.Diagnostics()
+	if len(diags) != 2 {
+		t.Fatalf("WordLoader.Diagnostics() returned %v diagnostics, want 2: %v", len(diags), diags)
+	}
+	if diags[0].Line != 3 || diags[1].Line != 5 {
+		t.Errorf("WordLoader.Diagnostics() lines = %d,%d, want 3,5", diags[0].Line, diags[1].Line)
+	}
+	if !strings.HasPrefix(diags[0].Error(), "testdata/partial.txt:3: ") {
+		t.Errorf("Diagnostic.Error() = %q, want path:line: prefix", diags[0].Error())
+	}
+}
+
+func TestWordLoader_LoadStrictDiagnostic(t *testing.T) {
+	loader := NewWordLoader()
+
+	_, _, err := loader.LoadFile("testdata/partial.txt")
+	var diag Diagnostic
+	if !errors.As(err, &diag) {
+		t.Fatalf("WordLoader.LoadFile() error = %v, want Diagnostic", err)
+	}
+	if diag.Path != "testdata/partial.txt" || diag.Line != 3 {
+		t.Errorf("WordLoader.LoadFile() diagnostic = %+v, want testdata/partial.txt:3", diag)
+	}
+}
+
+func TestWordLoader_LoadLenient(t *testing.T) {
+	loader := NewWordLoader()
+	loader.SetMode(LoadModeLenient)
+
+	if err := loader.Load("testdata"); err != nil {
+		t.Fatalf("WordLoader.Load() unexpected error = %v", err)
+	}
+
+	categories := make(map[string]bool)
+	for _, category := range loader.Categories() {
+		categories[category] = true
+	}
+	for _, want := range []string{"Colors", "Fruits", "Partial"} {
+		if !categories[want] {
+			t.Errorf("WordLoader.Load() missing category %s", want)
+		}
+	}
+	if categories["Invalid Category"] {
+		t.Error("WordLoader.Load() should skip files without valid words")
+	}
+
+	paths := make(map[string]bool)
+	for _, diag := range loader.Diagnostics() {
+		paths[filepath.ToSlash(diag.Path)] = true
+	}
+	for _, want := range []string{"testdata/empty.txt", "testdata/invalid.txt", "testdata/partial.txt"} {
+		if !paths[want] {
+			t.Errorf("WordLoader.Diagnostics() missing entry for %s", want)
+		}
+	}
+}
+
+func TestWordLoader_LoadMergesDuplicateCategories(t *testing.T) {
+	loader := NewWordLoader()
+	if err := loader.Load("testdata/ordered"); err != nil {
+		t.Fatalf("WordLoader.Load() unexpected error = %v", err)
+	}
+
+	expected := []string{"Zebra Things", "apple things", "Middle"}
+	if !reflect.DeepEqual(loader.Categories(), expected) {
+		t.Errorf("WordLoader.Categories() = %v, want %v", loader.Categories(), expected)
+	}
+
+	words, err := loader.GetWords("Zebra Things")
+	if err != nil {
+		t.Fatalf("WordLoader.GetWords() unexpected error = %v", err)
+	}
+	if len(words) != 2 {
+		t.Fatalf("WordLoader.GetWords() returned %v words, want 2", len(words))
+	}
+	if filepath.Base(words[1].Source.Path) != "d.txt" || words[1].Source.Line != 2 {
+		t.Errorf("WordLoader.GetWords() source = %+v, want d.txt:2", words[1].Source)
+	}
+}
+
+func TestWordLoader_CategoriesOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		order    CategoryOrder
+		plays    []string
+		expected []string
+	}{
+		{
+			name:     "file order",
+			order:    OrderFile,
+			expected: []string{"Zebra Things", "apple things", "Middle"},
+		},
+		{
+			name:     "alphabetical",
+			order:    OrderAlphabetical,
+			expected: []string{"apple things", "Middle", "Zebra Things"},
+		},
+		{
+			name:     "explicit",
+			order:    OrderExplicit,
+			expected: []string{"apple things", "Zebra Things", "Middle"},
+		},
+		{
+			name:     "most played",
+			order:    OrderMostPlayed,
+			plays:    []string{"Middle", "Middle", "apple things"},
+			expected: []string{"Middle", "apple things", "Zebra Things"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := NewWordLoader()
+			if err := loader.Load("testdata/ordered"); err != nil {
+				t.Fatalf("WordLoader.Load() unexpected error = %v", err)
+			}
+
+			loader.SetOrder(tt.order)
+			for _, category := range tt.plays {
+				loader.RecordPlay(category)
+			}
+
+			if !reflect.DeepEqual(loader.Categories(), tt.expected) {
+				t.Errorf("WordLoader.Categories() = %v, want %v", loader.Categories(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestWordLoader_LoadFileInvalidDirectives(t *testing.T) {
+	loader := NewWordLoader()
+	loader.SetMode(LoadModeLenient)
+
+	if _, _, err := loader.LoadFile("testdata/ordered_invalid.txt"); err != nil {
+		t.Fatalf("WordLoader.LoadFile() unexpected error = %v", err)
+	}
+	if len(loader.Diagnostics()) != 2 {
+		t.Errorf("WordLoader.Diagnostics() returned %v diagnostics, want 2: %v", len(loader.Diagnostics()), loader.Diagnostics())
+	}
+}
+
+func TestWordLoader_LoadFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"pack/animals.txt":             {Data: []byte("Animals\nCat,Kitty\nDog,Puppy\n")},
+		"pack/sports/football/epl.txt": {Data: []byte("EPL Teams\nArsenal,The Gunners\n")},
+		"pack/broken.txt":              {Data: []byte("Broken\n,NoWord\n")},
+		"pack/pack.json":               {Data: []byte("{\n  \"name\": \"animals\"\n}\n")},
+		"pack/.disabled":               {Data: []byte("")},
+		"pack/organiser.pub":           {Data: []byte("-----BEGIN PUBLIC KEY-----\nMCowBQYDK2VwAyEA\n")},
+		"pack/.git/HEAD":               {Data: []byte("ref: refs/heads/main\n")},
+	}
+
+	loader := NewWordLoader()
+	loader.SetMode(LoadModeLenient)
+	if err := loader.LoadFS(fsys, "pack"); err != nil {
+		t.Fatalf("WordLoader.LoadFS() unexpected error = %v", err)
+	}
+
+	expected := []string{"Animals", "EPL Teams"}
+	if !reflect.DeepEqual(loader.Categories(), expected) {
+		t.Errorf("WordLoader.Categories() = %v, want %v", loader.Categories(), expected)
+	}
+	if group := loader.CategoryGroup("EPL Teams"); group != "sports/football" {
+		t.Errorf("WordLoader.CategoryGroup() = %q, want sports/football", group)
+	}
+
+	diags := loader.Diagnostics()
+	if len(diags) == 0 || diags[0].Path != "pack/broken.txt" || diags[0].Line != 2 {
+		t.Errorf("WordLoader.Diagnostics() = %v, want first entry at pack/broken.txt:2", diags)
+	}
+}
+
+func TestParseWordLine(t *testing.T) {
+	tests := []struct {
+		name       string
+		line       string
+		want       Word
+		wantReason bool
+	}{
+		{"word and hint", "Cat,Kitty", Word{Text: "Cat", Hint: "Kitty"}, false},
+		{"word only", " Owl ", Word{Text: "Owl"}, false},
+		{"tags", "Cat,Kitty,[pet short]", Word{Text: "Cat", Hint: "Kitty", Tags: []string{"pet", "short"}}, false},
+		{"comma in hint", "Cat,Kitty, or puss", Word{}, true},
+		{"aliases", "Grey | Gray,A colour", Word{Text: "Grey", Aliases: []string{"Gray"}, Hint: "A colour"}, false},
+		{"hints", "Owl,Bird|Hoots|Wise", Word{Text: "Owl", Hint: "Bird", ExtraHints: []string{"Hoots", "Wise"}}, false},
+		{"empty extra hint", "Owl,Bird||Wise", Word{}, true},
+		{"empty alias", "Grey|,A colour", Word{}, true},
+		{"empty word", "|Gray,A colour", Word{}, true},
+		{"too many fields", "Cat,Kitty,[pet],extra", Word{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reason := parseWordLine(tt.line)
+			if (reason != "") != tt.wantReason {
+				t.Fatalf("parseWordLine() reason = %q, wantReason %v", reason, tt.wantReason)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseWordLine() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWord_Accepts(t *testing.T) {
+	word := Word{Text: "Brighton & Hove Albion", Aliases: []string{"Brighton", "The Seagulls"}}
+
+	tests := []struct {
+		attempt string
+		want    bool
+	}{
+		{"Brighton & Hove Albion", true},
+		{"brighton hove albion", true},
+		{"BRIGHTON", true},
+		{"the seagulls", true},
+		{"Hove", false},
+		{"", false},
+		{"&", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attempt, func(t *testing.T) {
+			if got := word.Accepts(tt.attempt); got != tt.want {
+				t.Errorf("Accepts(%q) = %v, want %v", tt.attempt, got, tt.want)
+			}
+		})
+	}
+}
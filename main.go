@@ -1,16 +1,146 @@
 package main
 
 import (
+	"database/sql"
+	"embed"
+	"flag"
 	"hangman/hangman"
+	"io/fs"
+	"os"
+	"strings"
 
 	"github.com/sirupsen/logrus"
+	_ "modernc.org/sqlite"
 )
 
+// builtinData is the default word pack, used when no data directory is present.
+//
+//go:embed data
+var builtinData embed.FS
+
 func main() {
-	hangman, err := hangman.NewHangman()
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "validate":
+			os.Exit(runValidate(os.Args[2:]))
+		case "pack":
+			os.Exit(runPack(os.Args[2:]))
+		case "encrypt":
+			os.Exit(runEncrypt(os.Args[2:]))
+		case "words":
+			os.Exit(runWords(os.Args[2:]))
+		case "edit":
+			os.Exit(runEdit(os.Args[2:]))
+		}
+	}
+
+	runGame(os.Args[1:])
+}
+
+// runGame parses the game flags and starts the interactive game.
+func runGame(args []string) {
+	cfg := hangman.DefaultConfig()
+	cfg.FallbackFS, _ = fs.Sub(builtinData, hangman.DefaultDataDir)
+	flags := flag.NewFlagSet("hangman", flag.ExitOnError)
+	strict := flags.Bool("strict", false, "abort on the first malformed word file instead of skipping it")
+	flags.StringVar(&cfg.DataDir, "data", cfg.DataDir, "directory containing word files")
+	flags.StringVar(&cfg.PacksDir, "packs", defaultPacksDir(), "directory containing installed word packs")
+	trust := flags.String("trust", "", "comma-separated PEM-encoded ed25519 public keys trusted to sign packs")
+	requireSigned := flags.Bool("require-signed", false, "refuse word packs that are not signed by a trusted key")
+	keyFile := flags.String("key-file", "", "key file for encrypted word files (default: passphrase from $"+passphraseEnv+")")
+	sqlitePath := flags.String("sqlite", "", "SQLite database with a words(category, text, hint) table to add to the menu")
+	catalogURL := flags.String("url", "", "base URL of an HTTP word catalog to add to the menu")
+	order := flags.String("order", string(cfg.CategoryOrder), "category menu order: file, alphabetical, explicit or most-played")
+	flags.IntVar(&cfg.Scoring.HintCost.Points, "hint-cost-points", cfg.Scoring.HintCost.Points, "points deducted for every extra hint")
+	flags.IntVar(&cfg.Scoring.HintCost.Lives, "hint-cost-lives", cfg.Scoring.HintCost.Lives, "guesses deducted for every extra hint")
+	saveFile, _ := hangman.DefaultSaveFile()
+	flags.StringVar(&cfg.SaveFile, "save", saveFile, "file where :save keeps the round in progress")
+	flags.BoolVar(&cfg.BatchGuesses, "batch", cfg.BatchGuesses, "accept several letters in one guess, e.g. aeiou")
+	flags.DurationVar(&cfg.Timing.PerGuess, "guess-time", 0, "time allowed for every guess, e.g. 15s; a late guess costs a life")
+	flags.DurationVar(&cfg.Timing.PerRound, "round-time", 0, "time allowed for every round, e.g. 2m")
+	flags.IntVar(&cfg.Scoring.TimeBonusPerSecond, "time-bonus", cfg.Scoring.TimeBonusPerSecond, "points per second left when a timed round is won")
+	flags.DurationVar(&cfg.TimeAttack.Duration, "time-attack", 0, "play time-attack runs of this length, e.g. 3m, instead of single rounds")
+	flags.IntVar(&cfg.Survival.Lives, "survival", 0, "play survival runs sharing this many lives across words, instead of single rounds")
+	flags.IntVar(&cfg.Survival.Refill, "survival-refill", cfg.Survival.Refill, "lives given back by a survival word solved without a wrong guess")
+	flags.IntVar(&cfg.Survival.RampEvery, "survival-ramp", cfg.Survival.RampEvery, "survival words solved before the words get harder, 0 keeps them easy")
+	flags.BoolVar(&cfg.Marathon.Enabled, "marathon", false, "play every word of a category once, in random order, instead of single rounds")
+	runCategories := flags.String("categories", "", "comma-separated categories a time-attack, survival or marathon run draws words from (default: chosen in the menu)")
+	playsFile, _ := hangman.DefaultPlaysFile()
+	flags.StringVar(&cfg.PlaysFile, "plays", playsFile, "file keeping the play counts used by -order most-played")
+	leaderboardFile, _ := hangman.DefaultLeaderboardFile()
+	flags.StringVar(&cfg.LeaderboardFile, "leaderboard", leaderboardFile, "file ranking classic rounds, time-attack runs, survival runs and marathons")
+	var powerUpFlags powerUpFlags
+	powerUpFlags.register(flags, cfg.Scoring.PowerUps)
+	var dictFlags dictionaryFlags
+	dictFlags.register(flags)
+	flags.Parse(args)
+
+	cfg.CategoryOrder = hangman.CategoryOrder(*order)
+	if *runCategories != "" {
+		for _, category := range strings.Split(*runCategories, ",") {
+			if category = strings.TrimSpace(category); category != "" {
+				cfg.TimeAttack.Categories = append(cfg.TimeAttack.Categories, category)
+			}
+		}
+		cfg.Survival.Categories = cfg.TimeAttack.Categories
+		cfg.Marathon.Categories = cfg.TimeAttack.Categories
+	}
+	powerUpFlags.apply(flags, &cfg.Scoring)
+
+	policy, err := packPolicy(*trust, *requireSigned)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	cfg.PackPolicy = policy
+
+	cfg.Secret, err = readSecret(*keyFile)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	if *sqlitePath != "" {
+		db, err := sql.Open("sqlite", *sqlitePath)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+		defer db.Close()
+
+		src, err := hangman.NewSQLSource(db, *sqlitePath)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+		cfg.Sources = append(cfg.Sources, src)
+	}
+
+	if *catalogURL != "" {
+		src, err := hangman.NewHTTPSource(nil, *catalogURL)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+		cfg.Sources = append(cfg.Sources, src)
+	}
+
+	dict, err := dictFlags.dictionary()
 	if err != nil {
 		logrus.Fatal(err)
 	}
+	if dict != nil {
+		cfg.Dictionaries = append(cfg.Dictionaries, *dict)
+	}
+
+	cfg.LoadMode = hangman.LoadModeLenient
+	if *strict {
+		cfg.LoadMode = hangman.LoadModeStrict
+	}
+
+	hangman, err := hangman.NewHangman(cfg)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	for _, diag := range hangman.WordLoader.Diagnostics() {
+		logrus.Warn(diag.Error())
+	}
 
 	hangman.Start()
 }
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"hangman/hangman"
+	"os"
+	"strings"
+)
+
+const packUsage = `usage: hangman pack [-dir packs] <command> [args]
+
+commands:
+  install <archive>  install a .zip, .tar, .tar.gz or .tgz word pack
+  list               list installed packs
+  info <name>        show details of an installed pack
+  enable <name>      load an installed pack again
+  disable <name>     keep a pack installed but stop loading it
+  remove <name>      uninstall a pack
+  keygen <prefix>    create an ed25519 signing key pair (<prefix>.key, <prefix>.pub)
+  sign -key <file> <dir>
+                     record checksums in <dir>/pack.json and sign it
+  verify [-pubkey <file>] [-require-signed] <name|dir>
+                     check checksums and signature of a pack`
+
+// defaultPacksDir returns the per-user packs directory, or "" when it cannot be determined.
+func defaultPacksDir() string {
+	dir, err := hangman.DefaultPacksDir()
+	if err != nil {
+		return ""
+	}
+	return dir
+}
+
+// runPack manages installed word packs and returns the process exit code.
+func runPack(args []string) int {
+	flags := flag.NewFlagSet("pack", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), packUsage)
+		flags.PrintDefaults()
+	}
+	dir := flags.String("dir", defaultPacksDir(), "directory containing installed word packs")
+	flags.Parse(args)
+
+	if flags.NArg() == 0 || *dir == "" {
+		flags.Usage()
+		return 2
+	}
+
+	store := hangman.NewPackStore(*dir)
+	command, rest := flags.Arg(0), flags.Args()[1:]
+
+	switch command {
+	case "list":
+		return packList(store)
+	case "sign":
+		return packSign(rest)
+	case "verify":
+		return packVerify(store, rest)
+	}
+
+	if len(rest) != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	var err error
+	switch command {
+	case "install":
+		var pack *hangman.InstalledPack
+		if pack, err = store.Install(rest[0]); err == nil {
+			fmt.Printf("installed %s %s into %s\n", pack.Name, pack.Version, pack.Dir)
+		}
+	case "info":
+		var pack *hangman.InstalledPack
+		if pack, err = store.Info(rest[0]); err == nil {
+			printPackInfo(pack)
+		}
+	case "enable":
+		err = store.Enable(rest[0])
+	case "disable":
+		err = store.Disable(rest[0])
+	case "remove":
+		err = store.Remove(rest[0])
+	case "keygen":
+		if err = hangman.GenerateSigningKey(rest[0]); err == nil {
+			fmt.Printf("wrote %s.key and %s.pub\n", rest[0], rest[0])
+		}
+	default:
+		flags.Usage()
+		return 2
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// packSign checksums and signs an unpacked pack directory.
+func packSign(args []string) int {
+	flags := flag.NewFlagSet("pack sign", flag.ExitOnError)
+	keyFile := flags.String("key", "", "PEM-encoded ed25519 private key")
+	flags.Parse(args)
+
+	if *keyFile == "" || flags.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: hangman pack sign -key <file> <dir>")
+		return 2
+	}
+
+	key, err := hangman.ReadPrivateKey(*keyFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	manifest, err := hangman.SignPack(flags.Arg(0), key)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	fmt.Printf("signed %s %s (%d files)\n", manifest.Name, manifest.Version, len(manifest.Files))
+	return 0
+}
+
+// packVerify checks an installed pack, or a pack directory, against a policy.
+func packVerify(store *hangman.PackStore, args []string) int {
+	flags := flag.NewFlagSet("pack verify", flag.ExitOnError)
+	pubkeys := flags.String("pubkey", "", "comma-separated PEM-encoded ed25519 public keys to trust")
+	requireSigned := flags.Bool("require-signed", false, "fail if the pack is not signed")
+	flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: hangman pack verify [-pubkey <file>] [-require-signed] <name|dir>")
+		return 2
+	}
+
+	policy, err := packPolicy(*pubkeys, *requireSigned)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	dir := flags.Arg(0)
+	if pack, err := store.Info(dir); err == nil {
+		dir = pack.Dir
+	}
+
+	manifest, err := hangman.VerifyPack(dir, policy)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	state := "unsigned"
+	if manifest.Signature != "" {
+		state = "signed"
+		if len(policy.TrustedKeys) == 0 {
+			state = "signed, signature not checked"
+		}
+	}
+	fmt.Printf("%s %s: OK (%s)\n", manifest.Name, manifest.Version, state)
+	return 0
+}
+
+// packPolicy builds a pack policy from a comma-separated list of public key files.
+func packPolicy(pubkeys string, requireSigned bool) (hangman.PackPolicy, error) {
+	policy := hangman.PackPolicy{RequireSigned: requireSigned}
+	for _, file := range strings.Split(pubkeys, ",") {
+		if file == "" {
+			continue
+		}
+
+		key, err := hangman.ReadPublicKey(file)
+		if err != nil {
+			return policy, err
+		}
+		policy.TrustedKeys = append(policy.TrustedKeys, key)
+	}
+	return policy, nil
+}
+
+// packList prints one line per installed pack.
+func packList(store *hangman.PackStore) int {
+	packs, diags, err := store.List()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	for _, diag := range diags {
+		fmt.Fprintln(os.Stderr, "skipped:", diag)
+	}
+
+	if len(packs) == 0 {
+		fmt.Printf("no packs installed in %s\n", store.Dir())
+		return 0
+	}
+
+	for _, pack := range packs {
+		state := "enabled"
+		if !pack.Enabled {
+			state = "disabled"
+		}
+		if pack.Signature != "" {
+			state += ",signed"
+		}
+		fmt.Printf("%s\t%s\t%s\t%s\n", pack.Name, pack.Version, pack.Author, state)
+	}
+	return 0
+}
+
+// printPackInfo prints the manifest details of a pack.
+func printPackInfo(pack *hangman.InstalledPack) {
+	fmt.Printf("Name:        %s\n", pack.Name)
+	fmt.Printf("Version:     %s\n", pack.Version)
+	fmt.Printf("Author:      %s\n", pack.Author)
+	if pack.Description != "" {
+		fmt.Printf("Description: %s\n", pack.Description)
+	}
+	fmt.Printf("Enabled:     %t\n", pack.Enabled)
+	fmt.Printf("Signed:      %t\n", pack.Signature != "")
+	fmt.Printf("Directory:   %s\n", pack.Dir)
+	fmt.Printf("Files:       %s\n", strings.Join(pack.Files, ", "))
+}
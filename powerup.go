@@ -0,0 +1,43 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"hangman/hangman"
+)
+
+// powerUpFlags collects the game flags that limit and price power-ups.
+type powerUpFlags struct {
+	limit int
+	rules map[hangman.PowerUp]*hangman.PowerUpRule
+}
+
+// register adds a limit flag shared by every power-up, and limit and cost
+// flags for each power-up starting from rules.
+func (p *powerUpFlags) register(flags *flag.FlagSet, rules map[hangman.PowerUp]hangman.PowerUpRule) {
+	flags.IntVar(&p.limit, "powerup-limit", 1, "times each power-up can be used per round, 0 disables power-ups")
+
+	p.rules = make(map[hangman.PowerUp]*hangman.PowerUpRule, len(hangman.PowerUps))
+	for _, powerUp := range hangman.PowerUps {
+		rule := rules[powerUp]
+		p.rules[powerUp] = &rule
+		name := "powerup-" + string(powerUp)
+		flags.IntVar(&rule.Limit, name+"-limit", rule.Limit, fmt.Sprintf("times the %s power-up can be used per round, overriding -powerup-limit", powerUp))
+		flags.IntVar(&rule.Cost.Points, name+"-points", rule.Cost.Points, fmt.Sprintf("points the %s power-up costs", powerUp))
+		flags.IntVar(&rule.Cost.Lives, name+"-lives", rule.Cost.Lives, fmt.Sprintf("guesses the %s power-up costs", powerUp))
+	}
+}
+
+// apply copies the parsed rules into scoring; -powerup-limit applies to every
+// power-up whose own limit flag was not set.
+func (p *powerUpFlags) apply(flags *flag.FlagSet, scoring *hangman.Scoring) {
+	set := make(map[string]bool)
+	flags.Visit(func(f *flag.Flag) { set[f.Name] = true })
+
+	for powerUp, rule := range p.rules {
+		if set["powerup-limit"] && !set["powerup-"+string(powerUp)+"-limit"] {
+			rule.Limit = p.limit
+		}
+		scoring.PowerUps[powerUp] = *rule
+	}
+}
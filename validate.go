@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"hangman/hangman"
+	"os"
+)
+
+// runValidate lints word packs and returns the process exit code.
+func runValidate(args []string) int {
+	opts := hangman.DefaultValidateOptions()
+	flags := flag.NewFlagSet("validate", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: hangman validate [flags] [path ...]")
+		flags.PrintDefaults()
+	}
+	format := flags.String("format", "text", "output format: text or json")
+	strict := flags.Bool("strict", false, "treat warnings as errors")
+	flags.IntVar(&opts.MaxWordLength, "max-word-length", opts.MaxWordLength, "longest allowed word, 0 disables the check")
+	flags.IntVar(&opts.MaxHintLength, "max-hint-length", opts.MaxHintLength, "longest allowed hint, 0 disables the check")
+	keyFile := flags.String("key-file", "", "key file for encrypted word files (default: passphrase from $"+passphraseEnv+")")
+	flags.Parse(args)
+
+	secret, err := readSecret(*keyFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	opts.Secret = secret
+
+	paths := flags.Args()
+	if len(paths) == 0 {
+		paths = []string{hangman.DefaultDataDir}
+	}
+
+	report, err := hangman.Validate(paths, opts)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	switch *format {
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 2
+		}
+	case "text":
+		for _, issue := range report.Issues {
+			fmt.Println(issue.String())
+		}
+		fmt.Printf("%d file(s), %d categories, %d words: %d error(s), %d warning(s)\n",
+			report.Files, report.Categories, report.Words,
+			report.Count(hangman.SeverityError), report.Count(hangman.SeverityWarning))
+	default:
+		fmt.Fprintf(os.Stderr, "unknown format %q\n", *format)
+		return 2
+	}
+
+	if report.HasErrors() || (*strict && len(report.Issues) > 0) {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"hangman/hangman"
+	"os"
+	"strings"
+)
+
+const wordsUsage = `usage: hangman words [-data dir] <command> [args]
+
+commands:
+  list [category]    list categories, or the words of a category
+  add [-aliases a] [-tags t] [-file f] <category> <word> <hint>
+                     append a word to the category's first file (or -file)
+  remove <category> <word>
+                     delete a word
+  edit [-text t] [-aliases a] [-hint h] [-tags t] <category> <word>
+                     change a word in place
+  move [-file f] <category> <word> <to-category>
+                     move a word to another category`
+
+// runWords edits the word files of a data directory and returns the process exit code.
+func runWords(args []string) int {
+	flags := flag.NewFlagSet("words", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), wordsUsage)
+		flags.PrintDefaults()
+	}
+	dir := flags.String("data", hangman.DefaultDataDir, "directory containing word files")
+	flags.Parse(args)
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	loader := hangman.NewWordLoader()
+	loader.SetMode(hangman.LoadModeLenient)
+	if err := loader.Load(*dir); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	command, rest := flags.Arg(0), flags.Args()[1:]
+	switch command {
+	case "list":
+		return wordsList(loader, rest)
+	case "add":
+		return wordsAdd(loader, rest)
+	case "remove":
+		return wordsRemove(loader, rest)
+	case "edit":
+		return wordsEdit(loader, rest)
+	case "move":
+		return wordsMove(loader, rest)
+	default:
+		flags.Usage()
+		return 2
+	}
+}
+
+// wordsList prints every category, or every word of one category.
+func wordsList(loader *hangman.WordLoader, args []string) int {
+	if len(args) > 1 {
+		fmt.Fprintln(os.Stderr, "usage: hangman words list [category]")
+		return 2
+	}
+
+	if len(args) == 0 {
+		for _, category := range loader.Categories() {
+			words, _ := loader.GetWords(category)
+			fmt.Printf("%s (%d words)\n", category, len(words))
+		}
+		return 0
+	}
+
+	words, err := loader.GetWords(args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", args[0], err)
+		return 1
+	}
+
+	for _, word := range words {
+		line := fmt.Sprintf("%s\t%s", word.Text, word.Hint)
+		if len(word.Tags) > 0 {
+			line += fmt.Sprintf("\t[%s]", strings.Join(word.Tags, " "))
+		}
+		fmt.Printf("%s\t%s:%d\n", line, word.Source.Path, word.Source.Line)
+	}
+	return 0
+}
+
+// wordsAdd appends a word to a category.
+func wordsAdd(loader *hangman.WordLoader, args []string) int {
+	flags := flag.NewFlagSet("words add", flag.ExitOnError)
+	aliases := flags.String("aliases", "", "alternative answers separated by "+hangman.AliasSeparator)
+	tags := flags.String("tags", "", "space-separated tags")
+	file := flags.String("file", "", "word file to add to (default: the category's first file)")
+	flags.Parse(args)
+
+	if flags.NArg() != 3 {
+		fmt.Fprintln(os.Stderr, "usage: hangman words add [-aliases a] [-tags t] [-file f] <category> <word> <hint>")
+		return 2
+	}
+
+	category := flags.Arg(0)
+	word := hangman.Word{Text: flags.Arg(1), Aliases: splitAliasFlag(*aliases), Hint: flags.Arg(2), Tags: strings.Fields(*tags)}
+	if err := addWord(loader, category, *file, word); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	fmt.Printf("added %q to %s\n", word.Text, category)
+	return 0
+}
+
+// wordsRemove deletes a word from a category.
+func wordsRemove(loader *hangman.WordLoader, args []string) int {
+	if len(args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: hangman words remove <category> <word>")
+		return 2
+	}
+
+	if err := removeWord(loader, args[0], args[1]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	fmt.Printf("removed %q from %s\n", args[1], args[0])
+	return 0
+}
+
+// wordsEdit changes the text, hint or tags of a word in place.
+func wordsEdit(loader *hangman.WordLoader, args []string) int {
+	flags := flag.NewFlagSet("words edit", flag.ExitOnError)
+	text := flags.String("text", "", "new word")
+	aliases := flags.String("aliases", "", "new alternative answers separated by "+hangman.AliasSeparator)
+	hint := flags.String("hint", "", "new hint")
+	tags := flags.String("tags", "", "new space-separated tags")
+	flags.Parse(args)
+
+	if flags.NArg() != 2 {
+		fmt.Fprintln(os.Stderr, "usage: hangman words edit [-text t] [-aliases a] [-hint h] [-tags t] <category> <word>")
+		return 2
+	}
+
+	found, file, err := openWord(loader, flags.Arg(0), flags.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	word := *found
+	flags.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "text":
+			word.Text = *text
+		case "aliases":
+			word.Aliases = splitAliasFlag(*aliases)
+		case "hint":
+			word.Hint = *hint
+		case "tags":
+			word.Tags = strings.Fields(*tags)
+		}
+	})
+
+	if !strings.EqualFold(word.Text, found.Text) {
+		if _, err := loader.FindWord(flags.Arg(0), word.Text); err == nil {
+			fmt.Fprintf(os.Stderr, "word %q already exists in %s\n", word.Text, flags.Arg(0))
+			return 1
+		}
+	}
+
+	if err := file.Replace(found.Text, word); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if err := file.Save(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	fmt.Printf("updated %q in %s\n", word.Text, file.Path)
+	return 0
+}
+
+// wordsMove moves a word to another category. The word is added to the
+// target before it is removed from the source, so an interrupted move leaves
+// a duplicate rather than losing the word.
+func wordsMove(loader *hangman.WordLoader, args []string) int {
+	flags := flag.NewFlagSet("words move", flag.ExitOnError)
+	file := flags.String("file", "", "word file to move to (default: the target category's first file)")
+	flags.Parse(args)
+
+	if flags.NArg() != 3 {
+		fmt.Fprintln(os.Stderr, "usage: hangman words move [-file f] <category> <word> <to-category>")
+		return 2
+	}
+
+	from, text, to := flags.Arg(0), flags.Arg(1), flags.Arg(2)
+	if from == to {
+		fmt.Fprintf(os.Stderr, "%q is already in %s\n", text, to)
+		return 1
+	}
+
+	word, err := loader.FindWord(from, text)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	moved := hangman.Word{Text: word.Text, Aliases: word.Aliases, Hint: word.Hint, Tags: word.Tags}
+
+	if err := addWord(loader, to, *file, moved); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if err := removeWord(loader, from, text); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	fmt.Printf("moved %q from %s to %s\n", moved.Text, from, to)
+	return 0
+}
+
+// splitAliasFlag splits an -aliases value into individual aliases.
+func splitAliasFlag(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, hangman.AliasSeparator)
+}
+
+// openWord finds a word of the category and opens the file it was loaded from.
+func openWord(loader *hangman.WordLoader, category, text string) (*hangman.Word, *hangman.WordFile, error) {
+	word, err := loader.FindWord(category, text)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := hangman.OpenWordFile(word.Source.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return word, file, nil
+}
+
+// addWord adds word to path, or to the category's first file when path is empty.
+func addWord(loader *hangman.WordLoader, category, path string, word hangman.Word) error {
+	if path == "" {
+		files := loader.CategoryFiles(category)
+		if len(files) == 0 {
+			return fmt.Errorf("category %q has no word files, pass -file to choose one", category)
+		}
+		path = files[0]
+	}
+
+	file, err := hangman.OpenWordFile(path)
+	if err != nil {
+		return err
+	}
+	if file.Category() != category {
+		return fmt.Errorf("%s belongs to category %q, not %q", path, file.Category(), category)
+	}
+	if _, err := loader.FindWord(category, word.Text); err == nil {
+		return fmt.Errorf("word %q already exists in %s", word.Text, category)
+	}
+
+	if err := file.Add(word); err != nil {
+		return err
+	}
+	return file.Save()
+}
+
+// removeWord deletes a word from the file it was loaded from.
+func removeWord(loader *hangman.WordLoader, category, text string) error {
+	found, file, err := openWord(loader, category, text)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Remove(found.Text); err != nil {
+		return err
+	}
+	return file.Save()
+}